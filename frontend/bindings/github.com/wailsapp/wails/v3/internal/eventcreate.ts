@@ -6,9 +6,29 @@
 // @ts-ignore: Unused imports
 import { Create as $Create } from "@wailsio/runtime";
 
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import * as model$0 from "../../../../../rocket-leaf/internal/model/models.js";
+
 function configure() {
     Object.freeze(Object.assign($Create.Events, {
+        "consumer:stuck": $$createType0,
+        "message:replay:items": $$createType1,
+        "message:replay:progress": $$createType2,
+        "message:search:match": $$createType3,
+        "message:search:progress": $$createType4,
+        "message:tail": $$createType5,
+        "message:tail:status": $$createType6,
     }));
 }
 
+// Private type creation functions
+const $$createType0 = model$0.StuckConsumerEvent.createFrom;
+const $$createType1 = model$0.MessageReplayItemsEvent.createFrom;
+const $$createType2 = model$0.MessageReplayProgress.createFrom;
+const $$createType3 = model$0.BodySearchMatchEvent.createFrom;
+const $$createType4 = model$0.BodySearchProgress.createFrom;
+const $$createType5 = model$0.TailMessagesEvent.createFrom;
+const $$createType6 = model$0.TailStatus.createFrom;
+
 configure();
@@ -5,9 +5,20 @@
 // @ts-ignore: Unused imports
 import type { Events } from "@wailsio/runtime";
 
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import type * as model$0 from "../../../../../rocket-leaf/internal/model/models.js";
+
 declare module "@wailsio/runtime" {
     namespace Events {
         interface CustomEvents {
+            "consumer:stuck": model$0.StuckConsumerEvent;
+            "message:replay:items": model$0.MessageReplayItemsEvent;
+            "message:replay:progress": model$0.MessageReplayProgress;
+            "message:search:match": model$0.BodySearchMatchEvent;
+            "message:search:progress": model$0.BodySearchProgress;
+            "message:tail": model$0.TailMessagesEvent;
+            "message:tail:status": model$0.TailStatus;
             "time": string;
         }
     }
@@ -2,24 +2,129 @@
 // This file is automatically generated. DO NOT EDIT
 
 export {
+    AllocationIssue,
+    AllocationIssueType,
+    BodyDecoder,
+    BodyEncoding,
+    BodySearchMatchEvent,
+    BodySearchMode,
+    BodySearchProgress,
+    BodySearchRequest,
     BrokerNode,
+    BrokerOperationResult,
     BrokerRole,
+    ClientQueueLoad,
+    ClientSubscription,
     ClusterInfo,
     ClusterSummary,
     Connection,
     ConnectionEnv,
     ConnectionStatus,
     ConsumeMode,
+    ConsumeType,
+    ConsumerGroupConfig,
     ConsumerGroupItem,
+    ConsumerRunningDetail,
+    DLQQueryParams,
+    DLQQueryResult,
+    DLQRedeliverItem,
+    DLQRedeliverRequest,
+    DLQRedeliverResult,
+    DecodeBodyRequest,
+    DecodedBody,
+    DecoderConfig,
+    DeleteGroupRequest,
+    DeleteGroupResult,
+    ExportFormat,
+    FilterExpressionType,
+    FilterPropertyStat,
+    FilterTagStat,
+    FilteredMessage,
+    GroupBrokerItem,
     GroupClient,
+    GroupHealth,
+    GroupHealthFinding,
+    GroupOffsetClonePlan,
+    GroupOffsetCloneResult,
     GroupStatus,
     GroupSubscription,
+    HealthLevel,
+    MessageExportRequest,
+    MessageExportResult,
+    MessageFilterRequest,
+    MessageFilterResult,
+    MessageIDInfo,
+    MessageIDType,
     MessageItem,
+    MessagePage,
+    MessageQueryParams,
+    MessageReplayItem,
+    MessageReplayItemsEvent,
+    MessageReplayProgress,
+    MessageReplayRequest,
     MessageStatus,
+    MessageTrace,
+    MessageTraceQuery,
+    MessageTraceResult,
     NameServerNode,
     NodeStatus,
+    OffsetDiffItem,
+    OffsetSnapshot,
+    OffsetSnapshotInfo,
+    OffsetSnapshotSource,
+    OffsetUpdateResult,
+    PoisonMessageCandidate,
+    PopConsumeStats,
+    PopQueueStats,
+    ProcessQueueItem,
+    ProducerClient,
+    ProducerGroupItem,
+    QueueAllocationResult,
+    QueueAssignment,
+    QueueBrowseParams,
+    QueueBrowseResult,
+    QueueLockItem,
+    QueueLockResult,
+    QueueLockState,
+    QueueOffset,
+    QueueUnlockResult,
+    QueueUnlockTarget,
+    RedeliverTarget,
+    RedeliveryRecord,
+    RetryMessageItem,
+    RetryQueryParams,
+    RetryQueryResult,
+    RetryRateBucket,
+    ReviveQueueStats,
+    SendMessageRequest,
+    SendMessageResult,
+    SkipBacklogItem,
+    SkipBacklogPlan,
+    SkipBacklogRequest,
+    SkipBacklogResult,
+    StuckConsumerEvent,
+    StuckDetectionRequest,
+    StuckDetectionStatus,
+    StuckQueue,
+    SubscriptionCheckResult,
+    SubscriptionIssue,
+    SubscriptionIssueType,
+    SwitchConsumeTypeRequest,
+    SwitchConsumeTypeResult,
+    TailMessagesEvent,
+    TailRequest,
+    TailStatus,
+    TopicAllocation,
+    TopicCleanupResult,
+    TopicConsumeStatus,
     TopicItem,
     TopicMessageType,
     TopicPerm,
-    TopicRouteItem
+    TopicProducers,
+    TopicRouteItem,
+    TraceEvent,
+    TraceGroupTimeline,
+    TraceRole,
+    TraceType,
+    UnlockQueuesRequest
 } from "./models.js";
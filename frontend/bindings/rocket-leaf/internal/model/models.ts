@@ -6,1034 +6,7968 @@
 import { Create as $Create } from "@wailsio/runtime";
 
 /**
- * BrokerNode Broker 节点信息
+ * AllocationIssue 队列分配问题
  */
-export class BrokerNode {
+export class AllocationIssue {
     /**
-     * 节点ID
+     * 问题类型
      */
-    "id": number;
+    "type": AllocationIssueType;
 
     /**
-     * 所属集群
+     * 相关 Topic
      */
-    "cluster": string;
+    "topic": string;
 
     /**
-     * Broker 名称
+     * 问题描述
      */
-    "brokerName": string;
+    "message": string;
 
     /**
-     * Broker ID
+     * 相关客户端
      */
-    "brokerId": number;
+    "clientIds": string[];
+
+    /** Creates a new AllocationIssue instance. */
+    constructor($$source: Partial<AllocationIssue> = {}) {
+        if (!("type" in $$source)) {
+            this["type"] = AllocationIssueType.$zero;
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("message" in $$source)) {
+            this["message"] = "";
+        }
+        if (!("clientIds" in $$source)) {
+            this["clientIds"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
 
     /**
-     * 角色(MASTER/SLAVE)
+     * Creates a new AllocationIssue instance from a string or object.
      */
-    "role": BrokerRole;
+    static createFrom($$source: any = {}): AllocationIssue {
+        const $$createField3_0 = $$createType0;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("clientIds" in $$parsedSource) {
+            $$parsedSource["clientIds"] = $$createField3_0($$parsedSource["clientIds"]);
+        }
+        return new AllocationIssue($$parsedSource as Partial<AllocationIssue>);
+    }
+}
 
+/**
+ * AllocationIssueType 队列分配问题类型
+ */
+export enum AllocationIssueType {
     /**
-     * 主地址
+     * The Go zero value for the underlying type of the enum.
      */
-    "address": string;
+    $zero = "",
 
     /**
-     * HA地址
+     * 队列未分配给任何客户端
      */
-    "haAddress": string;
+    AllocationUnassignedQueue = "unassignedQueue",
 
     /**
-     * 版本号
+     * 客户端未分配到队列
      */
-    "version": string;
+    AllocationIdleClient = "idleClient",
 
     /**
-     * 节点状态
+     * 客户端间队列数差异过大
      */
-    "status": NodeStatus;
+    AllocationImbalance = "imbalance",
 
     /**
-     * Topic 数量
+     * 同一队列被多个客户端持有
      */
-    "topics": number;
+    AllocationDuplicateOwner = "duplicateOwner",
 
     /**
-     * 消费者组数量
+     * 无法获取客户端运行时信息
      */
-    "groups": number;
+    AllocationClientUnreachable = "clientUnreachable",
+};
 
+/**
+ * BodyDecoder 消息体解码器
+ */
+export enum BodyDecoder {
     /**
-     * 入流 TPS
+     * The Go zero value for the underlying type of the enum.
      */
-    "tpsIn": number;
+    $zero = "",
 
     /**
-     * 出流 TPS
+     * 自动识别 JSON/XML/文本，二进制时输出十六进制
      */
-    "tpsOut": number;
+    DecoderAuto = "auto",
 
     /**
-     * TPS 入流历史
+     * UTF-8 文本
      */
-    "tpsInHistory": number[];
+    DecoderText = "text",
 
     /**
-     * TPS 出流历史
+     * 格式化 JSON
      */
-    "tpsOutHistory": number[];
+    DecoderJSON = "json",
 
     /**
-     * 今日入消息量
+     * 格式化 XML
      */
-    "msgInToday": number;
+    DecoderXML = "xml",
 
     /**
-     * 今日出消息量
+     * 十六进制转储
      */
-    "msgOutToday": number;
+    DecoderHex = "hex",
 
     /**
-     * CommitLog 磁盘使用率
+     * base64
      */
-    "commitLogDiskUsage": number;
+    DecoderBase64 = "base64",
 
     /**
-     * ConsumeQueue 磁盘使用率
+     * Protobuf，需提供描述符集合与消息类型
      */
-    "consumeQueueDiskUsage": number;
+    DecoderProtobuf = "protobuf",
 
     /**
-     * 最后更新时间
+     * Avro，需提供 Schema
      */
-    "lastUpdate": string;
+    DecoderAvro = "avro",
+};
 
+/**
+ * BodyEncoding 消息体在 MessageItem.Body 中的编码方式
+ */
+export enum BodyEncoding {
     /**
-     * 备注
+     * The Go zero value for the underlying type of the enum.
      */
-    "remark": string;
+    $zero = "",
 
-    /** Creates a new BrokerNode instance. */
-    constructor($$source: Partial<BrokerNode> = {}) {
-        if (!("id" in $$source)) {
-            this["id"] = 0;
-        }
-        if (!("cluster" in $$source)) {
-            this["cluster"] = "";
-        }
-        if (!("brokerName" in $$source)) {
-            this["brokerName"] = "";
-        }
-        if (!("brokerId" in $$source)) {
-            this["brokerId"] = 0;
-        }
-        if (!("role" in $$source)) {
-            this["role"] = BrokerRole.$zero;
-        }
-        if (!("address" in $$source)) {
-            this["address"] = "";
-        }
-        if (!("haAddress" in $$source)) {
-            this["haAddress"] = "";
-        }
-        if (!("version" in $$source)) {
-            this["version"] = "";
-        }
-        if (!("status" in $$source)) {
-            this["status"] = NodeStatus.$zero;
-        }
-        if (!("topics" in $$source)) {
-            this["topics"] = 0;
-        }
-        if (!("groups" in $$source)) {
-            this["groups"] = 0;
-        }
-        if (!("tpsIn" in $$source)) {
-            this["tpsIn"] = 0;
-        }
-        if (!("tpsOut" in $$source)) {
-            this["tpsOut"] = 0;
-        }
-        if (!("tpsInHistory" in $$source)) {
-            this["tpsInHistory"] = [];
-        }
-        if (!("tpsOutHistory" in $$source)) {
-            this["tpsOutHistory"] = [];
-        }
-        if (!("msgInToday" in $$source)) {
-            this["msgInToday"] = 0;
-        }
-        if (!("msgOutToday" in $$source)) {
-            this["msgOutToday"] = 0;
-        }
-        if (!("commitLogDiskUsage" in $$source)) {
-            this["commitLogDiskUsage"] = 0;
-        }
-        if (!("consumeQueueDiskUsage" in $$source)) {
-            this["consumeQueueDiskUsage"] = 0;
-        }
-        if (!("lastUpdate" in $$source)) {
-            this["lastUpdate"] = "";
+    /**
+     * UTF-8 文本
+     */
+    BodyEncodingText = "text",
+
+    /**
+     * 非 UTF-8 的二进制内容，以 base64 表示
+     */
+    BodyEncodingBase64 = "base64",
+};
+
+/**
+ * BodySearchMatchEvent 消息体搜索推送的一批命中消息
+ */
+export class BodySearchMatchEvent {
+    /**
+     * 搜索ID
+     */
+    "searchId": string;
+
+    /**
+     * 命中的消息
+     */
+    "messages": (MessageItem | null)[];
+
+    /** Creates a new BodySearchMatchEvent instance. */
+    constructor($$source: Partial<BodySearchMatchEvent> = {}) {
+        if (!("searchId" in $$source)) {
+            this["searchId"] = "";
         }
-        if (!("remark" in $$source)) {
-            this["remark"] = "";
+        if (!("messages" in $$source)) {
+            this["messages"] = [];
         }
 
         Object.assign(this, $$source);
     }
 
     /**
-     * Creates a new BrokerNode instance from a string or object.
+     * Creates a new BodySearchMatchEvent instance from a string or object.
      */
-    static createFrom($$source: any = {}): BrokerNode {
-        const $$createField13_0 = $$createType0;
-        const $$createField14_0 = $$createType0;
+    static createFrom($$source: any = {}): BodySearchMatchEvent {
+        const $$createField1_0 = $$createType3;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
-        if ("tpsInHistory" in $$parsedSource) {
-            $$parsedSource["tpsInHistory"] = $$createField13_0($$parsedSource["tpsInHistory"]);
-        }
-        if ("tpsOutHistory" in $$parsedSource) {
-            $$parsedSource["tpsOutHistory"] = $$createField14_0($$parsedSource["tpsOutHistory"]);
+        if ("messages" in $$parsedSource) {
+            $$parsedSource["messages"] = $$createField1_0($$parsedSource["messages"]);
         }
-        return new BrokerNode($$parsedSource as Partial<BrokerNode>);
+        return new BodySearchMatchEvent($$parsedSource as Partial<BodySearchMatchEvent>);
     }
 }
 
 /**
- * BrokerRole Broker 角色
+ * BodySearchMode 消息体搜索方式
  */
-export enum BrokerRole {
+export enum BodySearchMode {
     /**
      * The Go zero value for the underlying type of the enum.
      */
     $zero = "",
 
-    RoleMaster = "MASTER",
-    RoleSlave = "SLAVE",
+    /**
+     * 子串匹配
+     */
+    SearchSubstring = "substring",
+
+    /**
+     * 正则表达式
+     */
+    SearchRegex = "regex",
+
+    /**
+     * JSONPath 取值匹配
+     */
+    SearchJSONPath = "jsonpath",
 };
 
 /**
- * ClusterInfo 集群概览信息
+ * BodySearchProgress 消息体搜索进度
  */
-export class ClusterInfo {
+export class BodySearchProgress {
     /**
-     * 集群名称
+     * 搜索ID
      */
-    "clusterName": string;
+    "searchId": string;
 
     /**
-     * Broker 总数
+     * Topic 名称
      */
-    "totalBrokers": number;
+    "topic": string;
 
     /**
-     * 在线 Broker 数
+     * 已扫描消息数
      */
-    "onlineBrokers": number;
+    "scanned": number;
 
     /**
-     * Topic 总数
+     * 已命中消息数
      */
-    "totalTopics": number;
+    "matched": number;
 
     /**
-     * 消费者组总数
+     * 已完成的队列数
      */
-    "totalGroups": number;
+    "queuesDone": number;
 
     /**
-     * 平均磁盘使用率
+     * 队列总数
      */
-    "avgDiskUsage": number;
+    "queuesTotal": number;
 
     /**
-     * NameServer 列表
+     * 是否运行中
      */
-    "nameServers": string[];
+    "running": boolean;
 
     /**
-     * Broker 列表
+     * 是否因达到扫描上限或命中上限提前结束
      */
-    "brokers": (BrokerNode | null)[];
+    "truncated": boolean;
 
-    /** Creates a new ClusterInfo instance. */
-    constructor($$source: Partial<ClusterInfo> = {}) {
-        if (!("clusterName" in $$source)) {
-            this["clusterName"] = "";
+    /**
+     * 是否被取消
+     */
+    "cancelled": boolean;
+
+    /**
+     * 错误信息
+     */
+    "error": string;
+
+    /**
+     * 开始时间
+     */
+    "startedAt": string;
+
+    /**
+     * 已耗时(毫秒)
+     */
+    "elapsedMillis": number;
+
+    /** Creates a new BodySearchProgress instance. */
+    constructor($$source: Partial<BodySearchProgress> = {}) {
+        if (!("searchId" in $$source)) {
+            this["searchId"] = "";
         }
-        if (!("totalBrokers" in $$source)) {
-            this["totalBrokers"] = 0;
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
         }
-        if (!("onlineBrokers" in $$source)) {
-            this["onlineBrokers"] = 0;
+        if (!("scanned" in $$source)) {
+            this["scanned"] = 0;
         }
-        if (!("totalTopics" in $$source)) {
-            this["totalTopics"] = 0;
+        if (!("matched" in $$source)) {
+            this["matched"] = 0;
         }
-        if (!("totalGroups" in $$source)) {
-            this["totalGroups"] = 0;
+        if (!("queuesDone" in $$source)) {
+            this["queuesDone"] = 0;
         }
-        if (!("avgDiskUsage" in $$source)) {
-            this["avgDiskUsage"] = 0;
+        if (!("queuesTotal" in $$source)) {
+            this["queuesTotal"] = 0;
         }
-        if (!("nameServers" in $$source)) {
-            this["nameServers"] = [];
+        if (!("running" in $$source)) {
+            this["running"] = false;
         }
-        if (!("brokers" in $$source)) {
-            this["brokers"] = [];
+        if (!("truncated" in $$source)) {
+            this["truncated"] = false;
+        }
+        if (!("cancelled" in $$source)) {
+            this["cancelled"] = false;
+        }
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+        if (!("startedAt" in $$source)) {
+            this["startedAt"] = "";
+        }
+        if (!("elapsedMillis" in $$source)) {
+            this["elapsedMillis"] = 0;
         }
 
         Object.assign(this, $$source);
     }
 
     /**
-     * Creates a new ClusterInfo instance from a string or object.
+     * Creates a new BodySearchProgress instance from a string or object.
      */
-    static createFrom($$source: any = {}): ClusterInfo {
-        const $$createField6_0 = $$createType1;
-        const $$createField7_0 = $$createType4;
+    static createFrom($$source: any = {}): BodySearchProgress {
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
-        if ("nameServers" in $$parsedSource) {
-            $$parsedSource["nameServers"] = $$createField6_0($$parsedSource["nameServers"]);
-        }
-        if ("brokers" in $$parsedSource) {
-            $$parsedSource["brokers"] = $$createField7_0($$parsedSource["brokers"]);
-        }
-        return new ClusterInfo($$parsedSource as Partial<ClusterInfo>);
+        return new BodySearchProgress($$parsedSource as Partial<BodySearchProgress>);
     }
 }
 
 /**
- * ClusterSummary 集群状态概览（用于前端展示）
+ * BodySearchRequest 消息体全文搜索请求
  */
-export class ClusterSummary {
+export class BodySearchRequest {
     /**
-     * 集群数量
+     * Topic 名称
      */
-    "totalClusters": number;
+    "topic": string;
 
     /**
-     * Broker 总数
+     * 开始时间戳(毫秒)
      */
-    "totalBrokers": number;
+    "startTime": number;
 
     /**
-     * 在线 Broker 数
+     * 结束时间戳(毫秒)，0 表示当前时间
      */
-    "onlineBrokers": number;
+    "endTime": number;
 
     /**
-     * 告警 Broker 数
+     * 搜索方式
      */
-    "warningBrokers": number;
+    "mode": BodySearchMode;
 
     /**
-     * 离线 Broker 数
+     * 子串、正则表达式或 JSONPath（如 $.order.id、$.items[*].sku）
      */
-    "offlineBrokers": number;
+    "pattern": string;
 
     /**
-     * 平均磁盘使用率
+     * JSONPath 期望值，为空时只要求路径存在
      */
-    "avgDiskUsage": number;
+    "jsonValue": string;
 
-    /** Creates a new ClusterSummary instance. */
-    constructor($$source: Partial<ClusterSummary> = {}) {
-        if (!("totalClusters" in $$source)) {
-            this["totalClusters"] = 0;
+    /**
+     * 是否区分大小写
+     */
+    "caseSensitive": boolean;
+
+    /**
+     * 最多命中条数，达到后停止
+     */
+    "maxMatches": number;
+
+    /**
+     * 最多扫描的消息数（硬上限）
+     */
+    "scanLimit": number;
+
+    /**
+     * 并行拉取的队列数
+     */
+    "concurrency": number;
+
+    /** Creates a new BodySearchRequest instance. */
+    constructor($$source: Partial<BodySearchRequest> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
         }
-        if (!("totalBrokers" in $$source)) {
-            this["totalBrokers"] = 0;
+        if (!("startTime" in $$source)) {
+            this["startTime"] = 0;
         }
-        if (!("onlineBrokers" in $$source)) {
-            this["onlineBrokers"] = 0;
+        if (!("endTime" in $$source)) {
+            this["endTime"] = 0;
         }
-        if (!("warningBrokers" in $$source)) {
-            this["warningBrokers"] = 0;
+        if (!("mode" in $$source)) {
+            this["mode"] = BodySearchMode.$zero;
         }
-        if (!("offlineBrokers" in $$source)) {
-            this["offlineBrokers"] = 0;
+        if (!("pattern" in $$source)) {
+            this["pattern"] = "";
         }
-        if (!("avgDiskUsage" in $$source)) {
-            this["avgDiskUsage"] = 0;
+        if (!("jsonValue" in $$source)) {
+            this["jsonValue"] = "";
+        }
+        if (!("caseSensitive" in $$source)) {
+            this["caseSensitive"] = false;
+        }
+        if (!("maxMatches" in $$source)) {
+            this["maxMatches"] = 0;
+        }
+        if (!("scanLimit" in $$source)) {
+            this["scanLimit"] = 0;
+        }
+        if (!("concurrency" in $$source)) {
+            this["concurrency"] = 0;
         }
 
         Object.assign(this, $$source);
     }
 
     /**
-     * Creates a new ClusterSummary instance from a string or object.
+     * Creates a new BodySearchRequest instance from a string or object.
      */
-    static createFrom($$source: any = {}): ClusterSummary {
+    static createFrom($$source: any = {}): BodySearchRequest {
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
-        return new ClusterSummary($$parsedSource as Partial<ClusterSummary>);
+        return new BodySearchRequest($$parsedSource as Partial<BodySearchRequest>);
     }
 }
 
 /**
- * Connection 连接配置
+ * BrokerNode Broker 节点信息
  */
-export class Connection {
+export class BrokerNode {
     /**
-     * 连接ID
+     * 节点ID
      */
     "id": number;
 
     /**
-     * 连接名称
+     * 所属集群
+     */
+    "cluster": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * Broker ID
+     */
+    "brokerId": number;
+
+    /**
+     * 角色(MASTER/SLAVE)
+     */
+    "role": BrokerRole;
+
+    /**
+     * 主地址
+     */
+    "address": string;
+
+    /**
+     * HA地址
+     */
+    "haAddress": string;
+
+    /**
+     * 版本号
+     */
+    "version": string;
+
+    /**
+     * 节点状态
+     */
+    "status": NodeStatus;
+
+    /**
+     * Topic 数量
+     */
+    "topics": number;
+
+    /**
+     * 消费者组数量
+     */
+    "groups": number;
+
+    /**
+     * 入流 TPS
+     */
+    "tpsIn": number;
+
+    /**
+     * 出流 TPS
+     */
+    "tpsOut": number;
+
+    /**
+     * TPS 入流历史
+     */
+    "tpsInHistory": number[];
+
+    /**
+     * TPS 出流历史
+     */
+    "tpsOutHistory": number[];
+
+    /**
+     * 今日入消息量
+     */
+    "msgInToday": number;
+
+    /**
+     * 今日出消息量
+     */
+    "msgOutToday": number;
+
+    /**
+     * CommitLog 磁盘使用率
+     */
+    "commitLogDiskUsage": number;
+
+    /**
+     * ConsumeQueue 磁盘使用率
+     */
+    "consumeQueueDiskUsage": number;
+
+    /**
+     * 最后更新时间
+     */
+    "lastUpdate": string;
+
+    /**
+     * 备注
+     */
+    "remark": string;
+
+    /** Creates a new BrokerNode instance. */
+    constructor($$source: Partial<BrokerNode> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = 0;
+        }
+        if (!("cluster" in $$source)) {
+            this["cluster"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("brokerId" in $$source)) {
+            this["brokerId"] = 0;
+        }
+        if (!("role" in $$source)) {
+            this["role"] = BrokerRole.$zero;
+        }
+        if (!("address" in $$source)) {
+            this["address"] = "";
+        }
+        if (!("haAddress" in $$source)) {
+            this["haAddress"] = "";
+        }
+        if (!("version" in $$source)) {
+            this["version"] = "";
+        }
+        if (!("status" in $$source)) {
+            this["status"] = NodeStatus.$zero;
+        }
+        if (!("topics" in $$source)) {
+            this["topics"] = 0;
+        }
+        if (!("groups" in $$source)) {
+            this["groups"] = 0;
+        }
+        if (!("tpsIn" in $$source)) {
+            this["tpsIn"] = 0;
+        }
+        if (!("tpsOut" in $$source)) {
+            this["tpsOut"] = 0;
+        }
+        if (!("tpsInHistory" in $$source)) {
+            this["tpsInHistory"] = [];
+        }
+        if (!("tpsOutHistory" in $$source)) {
+            this["tpsOutHistory"] = [];
+        }
+        if (!("msgInToday" in $$source)) {
+            this["msgInToday"] = 0;
+        }
+        if (!("msgOutToday" in $$source)) {
+            this["msgOutToday"] = 0;
+        }
+        if (!("commitLogDiskUsage" in $$source)) {
+            this["commitLogDiskUsage"] = 0;
+        }
+        if (!("consumeQueueDiskUsage" in $$source)) {
+            this["consumeQueueDiskUsage"] = 0;
+        }
+        if (!("lastUpdate" in $$source)) {
+            this["lastUpdate"] = "";
+        }
+        if (!("remark" in $$source)) {
+            this["remark"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new BrokerNode instance from a string or object.
+     */
+    static createFrom($$source: any = {}): BrokerNode {
+        const $$createField13_0 = $$createType4;
+        const $$createField14_0 = $$createType4;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("tpsInHistory" in $$parsedSource) {
+            $$parsedSource["tpsInHistory"] = $$createField13_0($$parsedSource["tpsInHistory"]);
+        }
+        if ("tpsOutHistory" in $$parsedSource) {
+            $$parsedSource["tpsOutHistory"] = $$createField14_0($$parsedSource["tpsOutHistory"]);
+        }
+        return new BrokerNode($$parsedSource as Partial<BrokerNode>);
+    }
+}
+
+/**
+ * BrokerOperationResult 单个 Broker 上的操作结果
+ */
+export class BrokerOperationResult {
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * Broker 地址
+     */
+    "brokerAddr": string;
+
+    /**
+     * 是否成功
+     */
+    "success": boolean;
+
+    /**
+     * 错误信息
+     */
+    "error": string;
+
+    /** Creates a new BrokerOperationResult instance. */
+    constructor($$source: Partial<BrokerOperationResult> = {}) {
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("brokerAddr" in $$source)) {
+            this["brokerAddr"] = "";
+        }
+        if (!("success" in $$source)) {
+            this["success"] = false;
+        }
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new BrokerOperationResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): BrokerOperationResult {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new BrokerOperationResult($$parsedSource as Partial<BrokerOperationResult>);
+    }
+}
+
+/**
+ * BrokerRole Broker 角色
+ */
+export enum BrokerRole {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    RoleMaster = "MASTER",
+    RoleSlave = "SLAVE",
+};
+
+/**
+ * ClientQueueLoad 客户端在单个 Topic 上的队列负载
+ */
+export class ClientQueueLoad {
+    /**
+     * 客户端ID
+     */
+    "clientId": string;
+
+    /**
+     * 分配到的队列数
+     */
+    "queueCount": number;
+
+    /**
+     * 所持队列的堆积量合计
+     */
+    "lag": number;
+
+    /** Creates a new ClientQueueLoad instance. */
+    constructor($$source: Partial<ClientQueueLoad> = {}) {
+        if (!("clientId" in $$source)) {
+            this["clientId"] = "";
+        }
+        if (!("queueCount" in $$source)) {
+            this["queueCount"] = 0;
+        }
+        if (!("lag" in $$source)) {
+            this["lag"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ClientQueueLoad instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ClientQueueLoad {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ClientQueueLoad($$parsedSource as Partial<ClientQueueLoad>);
+    }
+}
+
+/**
+ * ClientSubscription 单个客户端的订阅关系
+ */
+export class ClientSubscription {
+    /**
+     * 客户端ID
+     */
+    "clientId": string;
+
+    /**
+     * 订阅关系列表
+     */
+    "subscriptions": GroupSubscription[];
+
+    /**
+     * 获取失败时的错误信息
+     */
+    "error": string;
+
+    /** Creates a new ClientSubscription instance. */
+    constructor($$source: Partial<ClientSubscription> = {}) {
+        if (!("clientId" in $$source)) {
+            this["clientId"] = "";
+        }
+        if (!("subscriptions" in $$source)) {
+            this["subscriptions"] = [];
+        }
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ClientSubscription instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ClientSubscription {
+        const $$createField1_0 = $$createType6;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("subscriptions" in $$parsedSource) {
+            $$parsedSource["subscriptions"] = $$createField1_0($$parsedSource["subscriptions"]);
+        }
+        return new ClientSubscription($$parsedSource as Partial<ClientSubscription>);
+    }
+}
+
+/**
+ * ClusterInfo 集群概览信息
+ */
+export class ClusterInfo {
+    /**
+     * 集群名称
+     */
+    "clusterName": string;
+
+    /**
+     * Broker 总数
+     */
+    "totalBrokers": number;
+
+    /**
+     * 在线 Broker 数
+     */
+    "onlineBrokers": number;
+
+    /**
+     * Topic 总数
+     */
+    "totalTopics": number;
+
+    /**
+     * 消费者组总数
+     */
+    "totalGroups": number;
+
+    /**
+     * 平均磁盘使用率
+     */
+    "avgDiskUsage": number;
+
+    /**
+     * NameServer 列表
+     */
+    "nameServers": string[];
+
+    /**
+     * Broker 列表
+     */
+    "brokers": (BrokerNode | null)[];
+
+    /** Creates a new ClusterInfo instance. */
+    constructor($$source: Partial<ClusterInfo> = {}) {
+        if (!("clusterName" in $$source)) {
+            this["clusterName"] = "";
+        }
+        if (!("totalBrokers" in $$source)) {
+            this["totalBrokers"] = 0;
+        }
+        if (!("onlineBrokers" in $$source)) {
+            this["onlineBrokers"] = 0;
+        }
+        if (!("totalTopics" in $$source)) {
+            this["totalTopics"] = 0;
+        }
+        if (!("totalGroups" in $$source)) {
+            this["totalGroups"] = 0;
+        }
+        if (!("avgDiskUsage" in $$source)) {
+            this["avgDiskUsage"] = 0;
+        }
+        if (!("nameServers" in $$source)) {
+            this["nameServers"] = [];
+        }
+        if (!("brokers" in $$source)) {
+            this["brokers"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ClusterInfo instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ClusterInfo {
+        const $$createField6_0 = $$createType0;
+        const $$createField7_0 = $$createType9;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("nameServers" in $$parsedSource) {
+            $$parsedSource["nameServers"] = $$createField6_0($$parsedSource["nameServers"]);
+        }
+        if ("brokers" in $$parsedSource) {
+            $$parsedSource["brokers"] = $$createField7_0($$parsedSource["brokers"]);
+        }
+        return new ClusterInfo($$parsedSource as Partial<ClusterInfo>);
+    }
+}
+
+/**
+ * ClusterSummary 集群状态概览（用于前端展示）
+ */
+export class ClusterSummary {
+    /**
+     * 集群数量
+     */
+    "totalClusters": number;
+
+    /**
+     * Broker 总数
+     */
+    "totalBrokers": number;
+
+    /**
+     * 在线 Broker 数
+     */
+    "onlineBrokers": number;
+
+    /**
+     * 告警 Broker 数
+     */
+    "warningBrokers": number;
+
+    /**
+     * 离线 Broker 数
+     */
+    "offlineBrokers": number;
+
+    /**
+     * 平均磁盘使用率
+     */
+    "avgDiskUsage": number;
+
+    /** Creates a new ClusterSummary instance. */
+    constructor($$source: Partial<ClusterSummary> = {}) {
+        if (!("totalClusters" in $$source)) {
+            this["totalClusters"] = 0;
+        }
+        if (!("totalBrokers" in $$source)) {
+            this["totalBrokers"] = 0;
+        }
+        if (!("onlineBrokers" in $$source)) {
+            this["onlineBrokers"] = 0;
+        }
+        if (!("warningBrokers" in $$source)) {
+            this["warningBrokers"] = 0;
+        }
+        if (!("offlineBrokers" in $$source)) {
+            this["offlineBrokers"] = 0;
+        }
+        if (!("avgDiskUsage" in $$source)) {
+            this["avgDiskUsage"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ClusterSummary instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ClusterSummary {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ClusterSummary($$parsedSource as Partial<ClusterSummary>);
+    }
+}
+
+/**
+ * Connection 连接配置
+ */
+export class Connection {
+    /**
+     * 连接ID
+     */
+    "id": number;
+
+    /**
+     * 连接名称
+     */
+    "name": string;
+
+    /**
+     * 环境类型
+     */
+    "env": ConnectionEnv;
+
+    /**
+     * NameServer 地址
+     */
+    "nameServer": string;
+
+    /**
+     * 超时时间(秒)
+     */
+    "timeoutSec": number;
+
+    /**
+     * 是否启用 ACL 认证
+     */
+    "enableACL": boolean;
+
+    /**
+     * ACL AccessKey
+     */
+    "accessKey": string;
+
+    /**
+     * ACL SecretKey
+     */
+    "secretKey": string;
+
+    /**
+     * 连接状态
+     */
+    "status": ConnectionStatus;
+
+    /**
+     * 最近检测时间
+     */
+    "lastCheck": string;
+
+    /**
+     * 是否默认连接
+     */
+    "isDefault": boolean;
+
+    /**
+     * 备注
+     */
+    "remark": string;
+
+    /** Creates a new Connection instance. */
+    constructor($$source: Partial<Connection> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = 0;
+        }
+        if (!("name" in $$source)) {
+            this["name"] = "";
+        }
+        if (!("env" in $$source)) {
+            this["env"] = ConnectionEnv.$zero;
+        }
+        if (!("nameServer" in $$source)) {
+            this["nameServer"] = "";
+        }
+        if (!("timeoutSec" in $$source)) {
+            this["timeoutSec"] = 0;
+        }
+        if (!("enableACL" in $$source)) {
+            this["enableACL"] = false;
+        }
+        if (!("accessKey" in $$source)) {
+            this["accessKey"] = "";
+        }
+        if (!("secretKey" in $$source)) {
+            this["secretKey"] = "";
+        }
+        if (!("status" in $$source)) {
+            this["status"] = ConnectionStatus.$zero;
+        }
+        if (!("lastCheck" in $$source)) {
+            this["lastCheck"] = "";
+        }
+        if (!("isDefault" in $$source)) {
+            this["isDefault"] = false;
+        }
+        if (!("remark" in $$source)) {
+            this["remark"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new Connection instance from a string or object.
+     */
+    static createFrom($$source: any = {}): Connection {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new Connection($$parsedSource as Partial<Connection>);
+    }
+}
+
+/**
+ * ConnectionEnv 连接环境类型
+ */
+export enum ConnectionEnv {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    EnvProduction = "生产",
+    EnvTest = "测试",
+    EnvDevelopment = "开发",
+};
+
+/**
+ * ConnectionStatus 连接状态
+ */
+export enum ConnectionStatus {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    StatusOnline = "online",
+    StatusOffline = "offline",
+};
+
+/**
+ * ConsumeMode 消费模式
+ */
+export enum ConsumeMode {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    ModeClustering = "CLUSTERING",
+    ModeBroadcasting = "BROADCASTING",
+};
+
+/**
+ * ConsumeType 消费类型
+ */
+export enum ConsumeType {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * Push 消费（CONSUME_PASSIVELY）
+     */
+    ConsumeTypePush = "PUSH",
+
+    /**
+     * Pull 消费（CONSUME_ACTIVELY）
+     */
+    ConsumeTypePull = "PULL",
+
+    /**
+     * Pop 消费（CONSUME_POP，RocketMQ 5.x）
+     */
+    ConsumeTypePop = "POP",
+
+    /**
+     * 无在线客户端，无法判断
+     */
+    ConsumeTypeUnknown = "UNKNOWN",
+};
+
+/**
+ * ConsumerGroupConfig 消费者组创建/更新配置（对应 Broker 端 SubscriptionGroupConfig）
+ */
+export class ConsumerGroupConfig {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 集群名称（为空且未指定 Broker 时作用于所有集群）
+     */
+    "cluster": string;
+
+    /**
+     * Broker 地址（指定时仅作用于该 Broker）
+     */
+    "brokerAddr": string;
+
+    /**
+     * 已存在该消费者组的 Broker 名称（只读）
+     */
+    "brokers": string[];
+
+    /**
+     * 消费模式
+     */
+    "consumeMode": ConsumeMode;
+
+    /**
+     * 是否允许消费
+     */
+    "consumeEnable": boolean;
+
+    /**
+     * 新队列是否从最小位点开始消费
+     */
+    "consumeFromMinEnable": boolean;
+
+    /**
+     * 是否顺序消费（5.x）
+     */
+    "consumeOrderly": boolean;
+
+    /**
+     * 最大重试次数
+     */
+    "maxRetry": number;
+
+    /**
+     * 重试队列数
+     */
+    "retryQueueNums": number;
+
+    /**
+     * 默认从哪个 Broker 消费
+     */
+    "brokerId": number;
+
+    /**
+     * 消费缓慢时切换到的 Broker
+     */
+    "whichBrokerWhenConsumeSlowly": number;
+
+    /**
+     * 客户端变化时是否通知重平衡
+     */
+    "notifyConsumerIdsChanged": boolean;
+
+    /**
+     * 系统标志位
+     */
+    "groupSysFlag": number;
+
+    /**
+     * 消费超时时间(分钟)
+     */
+    "consumeTimeoutMinute": number;
+
+    /**
+     * 扩展属性（5.x）
+     */
+    "attributes": { [_ in string]?: string };
+
+    /**
+     * 备注
+     */
+    "remark": string;
+
+    /**
+     * 需要写入的字段（JSON 字段名），创建时为空表示全部字段，更新时必填
+     */
+    "fields": string[];
+
+    /** Creates a new ConsumerGroupConfig instance. */
+    constructor($$source: Partial<ConsumerGroupConfig> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("cluster" in $$source)) {
+            this["cluster"] = "";
+        }
+        if (!("brokerAddr" in $$source)) {
+            this["brokerAddr"] = "";
+        }
+        if (!("brokers" in $$source)) {
+            this["brokers"] = [];
+        }
+        if (!("consumeMode" in $$source)) {
+            this["consumeMode"] = ConsumeMode.$zero;
+        }
+        if (!("consumeEnable" in $$source)) {
+            this["consumeEnable"] = false;
+        }
+        if (!("consumeFromMinEnable" in $$source)) {
+            this["consumeFromMinEnable"] = false;
+        }
+        if (!("consumeOrderly" in $$source)) {
+            this["consumeOrderly"] = false;
+        }
+        if (!("maxRetry" in $$source)) {
+            this["maxRetry"] = 0;
+        }
+        if (!("retryQueueNums" in $$source)) {
+            this["retryQueueNums"] = 0;
+        }
+        if (!("brokerId" in $$source)) {
+            this["brokerId"] = 0;
+        }
+        if (!("whichBrokerWhenConsumeSlowly" in $$source)) {
+            this["whichBrokerWhenConsumeSlowly"] = 0;
+        }
+        if (!("notifyConsumerIdsChanged" in $$source)) {
+            this["notifyConsumerIdsChanged"] = false;
+        }
+        if (!("groupSysFlag" in $$source)) {
+            this["groupSysFlag"] = 0;
+        }
+        if (!("consumeTimeoutMinute" in $$source)) {
+            this["consumeTimeoutMinute"] = 0;
+        }
+        if (!("attributes" in $$source)) {
+            this["attributes"] = {};
+        }
+        if (!("remark" in $$source)) {
+            this["remark"] = "";
+        }
+        if (!("fields" in $$source)) {
+            this["fields"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ConsumerGroupConfig instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ConsumerGroupConfig {
+        const $$createField3_0 = $$createType0;
+        const $$createField15_0 = $$createType10;
+        const $$createField17_0 = $$createType0;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("brokers" in $$parsedSource) {
+            $$parsedSource["brokers"] = $$createField3_0($$parsedSource["brokers"]);
+        }
+        if ("attributes" in $$parsedSource) {
+            $$parsedSource["attributes"] = $$createField15_0($$parsedSource["attributes"]);
+        }
+        if ("fields" in $$parsedSource) {
+            $$parsedSource["fields"] = $$createField17_0($$parsedSource["fields"]);
+        }
+        return new ConsumerGroupConfig($$parsedSource as Partial<ConsumerGroupConfig>);
+    }
+}
+
+/**
+ * ConsumerGroupItem 消费者组信息
+ */
+export class ConsumerGroupItem {
+    /**
+     * 消费者组ID
+     */
+    "id": number;
+
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 所属集群
+     */
+    "cluster": string;
+
+    /**
+     * 消费模式
+     */
+    "consumeMode": ConsumeMode;
+
+    /**
+     * 消费类型
+     */
+    "consumeType": ConsumeType;
+
+    /**
+     * 状态
+     */
+    "status": GroupStatus;
+
+    /**
+     * 在线客户端数
+     */
+    "onlineClients": number;
+
+    /**
+     * 订阅 Topic 数
+     */
+    "topicCount": number;
+
+    /**
+     * 堆积量
+     */
+    "lag": number;
+
+    /**
+     * 重试 QPS
+     */
+    "retryQps": number;
+
+    /**
+     * 死信数量
+     */
+    "dlq": number;
+
+    /**
+     * 最大重试次数
+     */
+    "maxRetry": number;
+
+    /**
+     * 同一语言的客户端版本是否不一致
+     */
+    "mixedVersions": boolean;
+
+    /**
+     * 低于推荐版本的客户端数
+     */
+    "outdatedCount": number;
+
+    /**
+     * 最后更新时间
+     */
+    "lastUpdate": string;
+
+    /**
+     * 备注
+     */
+    "remark": string;
+
+    /**
+     * 订阅关系列表
+     */
+    "subscriptions": GroupSubscription[];
+
+    /**
+     * 客户端列表
+     */
+    "clients": GroupClient[];
+
+    /** Creates a new ConsumerGroupItem instance. */
+    constructor($$source: Partial<ConsumerGroupItem> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = 0;
+        }
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("cluster" in $$source)) {
+            this["cluster"] = "";
+        }
+        if (!("consumeMode" in $$source)) {
+            this["consumeMode"] = ConsumeMode.$zero;
+        }
+        if (!("consumeType" in $$source)) {
+            this["consumeType"] = ConsumeType.$zero;
+        }
+        if (!("status" in $$source)) {
+            this["status"] = GroupStatus.$zero;
+        }
+        if (!("onlineClients" in $$source)) {
+            this["onlineClients"] = 0;
+        }
+        if (!("topicCount" in $$source)) {
+            this["topicCount"] = 0;
+        }
+        if (!("lag" in $$source)) {
+            this["lag"] = 0;
+        }
+        if (!("retryQps" in $$source)) {
+            this["retryQps"] = 0;
+        }
+        if (!("dlq" in $$source)) {
+            this["dlq"] = 0;
+        }
+        if (!("maxRetry" in $$source)) {
+            this["maxRetry"] = 0;
+        }
+        if (!("mixedVersions" in $$source)) {
+            this["mixedVersions"] = false;
+        }
+        if (!("outdatedCount" in $$source)) {
+            this["outdatedCount"] = 0;
+        }
+        if (!("lastUpdate" in $$source)) {
+            this["lastUpdate"] = "";
+        }
+        if (!("remark" in $$source)) {
+            this["remark"] = "";
+        }
+        if (!("subscriptions" in $$source)) {
+            this["subscriptions"] = [];
+        }
+        if (!("clients" in $$source)) {
+            this["clients"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ConsumerGroupItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ConsumerGroupItem {
+        const $$createField16_0 = $$createType6;
+        const $$createField17_0 = $$createType12;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("subscriptions" in $$parsedSource) {
+            $$parsedSource["subscriptions"] = $$createField16_0($$parsedSource["subscriptions"]);
+        }
+        if ("clients" in $$parsedSource) {
+            $$parsedSource["clients"] = $$createField17_0($$parsedSource["clients"]);
+        }
+        return new ConsumerGroupItem($$parsedSource as Partial<ConsumerGroupItem>);
+    }
+}
+
+/**
+ * ConsumerRunningDetail 消费者客户端运行时信息
+ */
+export class ConsumerRunningDetail {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 客户端ID
+     */
+    "clientId": string;
+
+    /**
+     * 消费类型
+     */
+    "consumeType": string;
+
+    /**
+     * 消息模式
+     */
+    "messageModel": string;
+
+    /**
+     * 是否顺序消费
+     */
+    "consumeOrderly": boolean;
+
+    /**
+     * 消费线程池核心线程数
+     */
+    "threadPoolCore": number;
+
+    /**
+     * 最小消费线程数
+     */
+    "threadPoolMin": number;
+
+    /**
+     * 最大消费线程数
+     */
+    "threadPoolMax": number;
+
+    /**
+     * 客户端版本
+     */
+    "clientVersion": string;
+
+    /**
+     * 客户端启动时间
+     */
+    "startTime": string;
+
+    /**
+     * 订阅关系
+     */
+    "subscriptions": GroupSubscription[];
+
+    /**
+     * 处理队列
+     */
+    "processQueues": ProcessQueueItem[];
+
+    /**
+     * 各 Topic 消费状态
+     */
+    "topicStatus": TopicConsumeStatus[];
+
+    /**
+     * 原始属性
+     */
+    "properties": { [_ in string]?: string };
+
+    /**
+     * 线程栈（客户端支持时返回）
+     */
+    "jstack": string;
+
+    /**
+     * 查询时间
+     */
+    "queriedAt": string;
+
+    /** Creates a new ConsumerRunningDetail instance. */
+    constructor($$source: Partial<ConsumerRunningDetail> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("clientId" in $$source)) {
+            this["clientId"] = "";
+        }
+        if (!("consumeType" in $$source)) {
+            this["consumeType"] = "";
+        }
+        if (!("messageModel" in $$source)) {
+            this["messageModel"] = "";
+        }
+        if (!("consumeOrderly" in $$source)) {
+            this["consumeOrderly"] = false;
+        }
+        if (!("threadPoolCore" in $$source)) {
+            this["threadPoolCore"] = 0;
+        }
+        if (!("threadPoolMin" in $$source)) {
+            this["threadPoolMin"] = 0;
+        }
+        if (!("threadPoolMax" in $$source)) {
+            this["threadPoolMax"] = 0;
+        }
+        if (!("clientVersion" in $$source)) {
+            this["clientVersion"] = "";
+        }
+        if (!("startTime" in $$source)) {
+            this["startTime"] = "";
+        }
+        if (!("subscriptions" in $$source)) {
+            this["subscriptions"] = [];
+        }
+        if (!("processQueues" in $$source)) {
+            this["processQueues"] = [];
+        }
+        if (!("topicStatus" in $$source)) {
+            this["topicStatus"] = [];
+        }
+        if (!("properties" in $$source)) {
+            this["properties"] = {};
+        }
+        if (!("jstack" in $$source)) {
+            this["jstack"] = "";
+        }
+        if (!("queriedAt" in $$source)) {
+            this["queriedAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ConsumerRunningDetail instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ConsumerRunningDetail {
+        const $$createField10_0 = $$createType6;
+        const $$createField11_0 = $$createType14;
+        const $$createField12_0 = $$createType16;
+        const $$createField13_0 = $$createType10;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("subscriptions" in $$parsedSource) {
+            $$parsedSource["subscriptions"] = $$createField10_0($$parsedSource["subscriptions"]);
+        }
+        if ("processQueues" in $$parsedSource) {
+            $$parsedSource["processQueues"] = $$createField11_0($$parsedSource["processQueues"]);
+        }
+        if ("topicStatus" in $$parsedSource) {
+            $$parsedSource["topicStatus"] = $$createField12_0($$parsedSource["topicStatus"]);
+        }
+        if ("properties" in $$parsedSource) {
+            $$parsedSource["properties"] = $$createField13_0($$parsedSource["properties"]);
+        }
+        return new ConsumerRunningDetail($$parsedSource as Partial<ConsumerRunningDetail>);
+    }
+}
+
+/**
+ * DLQQueryParams 死信消息查询参数
+ */
+export class DLQQueryParams {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 开始时间戳(毫秒)，0 表示不限
+     */
+    "startTime": number;
+
+    /**
+     * 结束时间戳(毫秒)，0 表示不限
+     */
+    "endTime": number;
+
+    /**
+     * 按消息 Key 或消息ID过滤
+     */
+    "key": string;
+
+    /**
+     * 最大返回数量
+     */
+    "maxResults": number;
+
+    /**
+     * 最多扫描的消息数，<=0 表示默认值
+     */
+    "scanLimit": number;
+
+    /** Creates a new DLQQueryParams instance. */
+    constructor($$source: Partial<DLQQueryParams> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("startTime" in $$source)) {
+            this["startTime"] = 0;
+        }
+        if (!("endTime" in $$source)) {
+            this["endTime"] = 0;
+        }
+        if (!("key" in $$source)) {
+            this["key"] = "";
+        }
+        if (!("maxResults" in $$source)) {
+            this["maxResults"] = 0;
+        }
+        if (!("scanLimit" in $$source)) {
+            this["scanLimit"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DLQQueryParams instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DLQQueryParams {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new DLQQueryParams($$parsedSource as Partial<DLQQueryParams>);
+    }
+}
+
+/**
+ * DLQQueryResult 死信消息查询结果
+ */
+export class DLQQueryResult {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 死信 Topic
+     */
+    "topic": string;
+
+    /**
+     * 消息列表
+     */
+    "messages": (MessageItem | null)[];
+
+    /**
+     * 已扫描消息数
+     */
+    "scanned": number;
+
+    /**
+     * 是否因达到上限被截断
+     */
+    "truncated": boolean;
+
+    /** Creates a new DLQQueryResult instance. */
+    constructor($$source: Partial<DLQQueryResult> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("messages" in $$source)) {
+            this["messages"] = [];
+        }
+        if (!("scanned" in $$source)) {
+            this["scanned"] = 0;
+        }
+        if (!("truncated" in $$source)) {
+            this["truncated"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DLQQueryResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DLQQueryResult {
+        const $$createField2_0 = $$createType3;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("messages" in $$parsedSource) {
+            $$parsedSource["messages"] = $$createField2_0($$parsedSource["messages"]);
+        }
+        return new DLQQueryResult($$parsedSource as Partial<DLQQueryResult>);
+    }
+}
+
+/**
+ * DLQRedeliverItem 单条死信消息重投结果
+ */
+export class DLQRedeliverItem {
+    /**
+     * 死信消息ID
+     */
+    "messageId": string;
+
+    /**
+     * 原始 Topic
+     */
+    "originTopic": string;
+
+    /**
+     * 投递目标 Topic
+     */
+    "targetTopic": string;
+
+    /**
+     * 新消息ID
+     */
+    "newMessageId": string;
+
+    /**
+     * 是否成功
+     */
+    "success": boolean;
+
+    /**
+     * 是否因已重投而跳过
+     */
+    "skipped": boolean;
+
+    /**
+     * 错误信息
+     */
+    "error": string;
+
+    /** Creates a new DLQRedeliverItem instance. */
+    constructor($$source: Partial<DLQRedeliverItem> = {}) {
+        if (!("messageId" in $$source)) {
+            this["messageId"] = "";
+        }
+        if (!("originTopic" in $$source)) {
+            this["originTopic"] = "";
+        }
+        if (!("targetTopic" in $$source)) {
+            this["targetTopic"] = "";
+        }
+        if (!("newMessageId" in $$source)) {
+            this["newMessageId"] = "";
+        }
+        if (!("success" in $$source)) {
+            this["success"] = false;
+        }
+        if (!("skipped" in $$source)) {
+            this["skipped"] = false;
+        }
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DLQRedeliverItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DLQRedeliverItem {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new DLQRedeliverItem($$parsedSource as Partial<DLQRedeliverItem>);
+    }
+}
+
+/**
+ * DLQRedeliverRequest 死信消息重投请求
+ */
+export class DLQRedeliverRequest {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 选中的消息ID，为空时按过滤条件重投
+     */
+    "messageIds": string[];
+
+    /**
+     * 过滤条件（MessageIDs 为空时生效）
+     */
+    "filter": DLQQueryParams;
+
+    /**
+     * 重投目标
+     */
+    "target": RedeliverTarget;
+
+    /**
+     * 每秒最多发送条数，<=0 表示默认值，最大 1000
+     */
+    "ratePerSecond": number;
+
+    /**
+     * 是否忽略重投记录再次投递
+     */
+    "force": boolean;
+
+    /** Creates a new DLQRedeliverRequest instance. */
+    constructor($$source: Partial<DLQRedeliverRequest> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("messageIds" in $$source)) {
+            this["messageIds"] = [];
+        }
+        if (!("filter" in $$source)) {
+            this["filter"] = (new DLQQueryParams());
+        }
+        if (!("target" in $$source)) {
+            this["target"] = RedeliverTarget.$zero;
+        }
+        if (!("ratePerSecond" in $$source)) {
+            this["ratePerSecond"] = 0;
+        }
+        if (!("force" in $$source)) {
+            this["force"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DLQRedeliverRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DLQRedeliverRequest {
+        const $$createField1_0 = $$createType0;
+        const $$createField2_0 = $$createType17;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("messageIds" in $$parsedSource) {
+            $$parsedSource["messageIds"] = $$createField1_0($$parsedSource["messageIds"]);
+        }
+        if ("filter" in $$parsedSource) {
+            $$parsedSource["filter"] = $$createField2_0($$parsedSource["filter"]);
+        }
+        return new DLQRedeliverRequest($$parsedSource as Partial<DLQRedeliverRequest>);
+    }
+}
+
+/**
+ * DLQRedeliverResult 死信消息重投结果
+ */
+export class DLQRedeliverResult {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 处理消息数
+     */
+    "total": number;
+
+    /**
+     * 成功数
+     */
+    "succeeded": number;
+
+    /**
+     * 跳过数
+     */
+    "skipped": number;
+
+    /**
+     * 失败数
+     */
+    "failed": number;
+
+    /**
+     * 逐条结果
+     */
+    "items": DLQRedeliverItem[];
+
+    /** Creates a new DLQRedeliverResult instance. */
+    constructor($$source: Partial<DLQRedeliverResult> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("total" in $$source)) {
+            this["total"] = 0;
+        }
+        if (!("succeeded" in $$source)) {
+            this["succeeded"] = 0;
+        }
+        if (!("skipped" in $$source)) {
+            this["skipped"] = 0;
+        }
+        if (!("failed" in $$source)) {
+            this["failed"] = 0;
+        }
+        if (!("items" in $$source)) {
+            this["items"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DLQRedeliverResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DLQRedeliverResult {
+        const $$createField5_0 = $$createType19;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("items" in $$parsedSource) {
+            $$parsedSource["items"] = $$createField5_0($$parsedSource["items"]);
+        }
+        return new DLQRedeliverResult($$parsedSource as Partial<DLQRedeliverResult>);
+    }
+}
+
+/**
+ * DecodeBodyRequest 消息体解码请求
+ */
+export class DecodeBodyRequest {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 消息ID
+     */
+    "msgId": string;
+
+    /**
+     * 解码配置，为 nil 时使用 Topic 记住的解码器
+     */
+    "config": DecoderConfig | null;
+
+    /** Creates a new DecodeBodyRequest instance. */
+    constructor($$source: Partial<DecodeBodyRequest> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("msgId" in $$source)) {
+            this["msgId"] = "";
+        }
+        if (!("config" in $$source)) {
+            this["config"] = null;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DecodeBodyRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DecodeBodyRequest {
+        const $$createField2_0 = $$createType21;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("config" in $$parsedSource) {
+            $$parsedSource["config"] = $$createField2_0($$parsedSource["config"]);
+        }
+        return new DecodeBodyRequest($$parsedSource as Partial<DecodeBodyRequest>);
+    }
+}
+
+/**
+ * DecodedBody 消息体解码结果
+ */
+export class DecodedBody {
+    /**
+     * 实际使用的解码器
+     */
+    "decoder": BodyDecoder;
+
+    /**
+     * 消息体压缩算法（zlib/lz4/zstd），未压缩时为空
+     */
+    "compression": string;
+
+    /**
+     * 解码的仍是压缩数据：解压失败时保留原始数据
+     */
+    "compressed": boolean;
+
+    /**
+     * 解码后的内容
+     */
+    "content": string;
+
+    /**
+     * 解压后的消息体字节数
+     */
+    "size": number;
+
+    /**
+     * 原始消息体字节数
+     */
+    "rawSize": number;
+
+    /**
+     * 指定解码器失败时的错误信息（此时 Content 为自动识别结果）
+     */
+    "error": string;
+
+    /** Creates a new DecodedBody instance. */
+    constructor($$source: Partial<DecodedBody> = {}) {
+        if (!("decoder" in $$source)) {
+            this["decoder"] = BodyDecoder.$zero;
+        }
+        if (!("compression" in $$source)) {
+            this["compression"] = "";
+        }
+        if (!("compressed" in $$source)) {
+            this["compressed"] = false;
+        }
+        if (!("content" in $$source)) {
+            this["content"] = "";
+        }
+        if (!("size" in $$source)) {
+            this["size"] = 0;
+        }
+        if (!("rawSize" in $$source)) {
+            this["rawSize"] = 0;
+        }
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DecodedBody instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DecodedBody {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new DecodedBody($$parsedSource as Partial<DecodedBody>);
+    }
+}
+
+/**
+ * DecoderConfig 消息体解码配置
+ */
+export class DecoderConfig {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 解码器
+     */
+    "decoder": BodyDecoder;
+
+    /**
+     * Protobuf 描述符集合文件路径（protoc --descriptor_set_out --include_imports）
+     */
+    "protoDescriptor": string;
+
+    /**
+     * Protobuf 消息全名，如 com.example.OrderEvent
+     */
+    "protoMessageType": string;
+
+    /**
+     * Avro Schema（JSON）
+     */
+    "avroSchema": string;
+
+    /**
+     * 更新时间
+     */
+    "updatedAt": string;
+
+    /** Creates a new DecoderConfig instance. */
+    constructor($$source: Partial<DecoderConfig> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("decoder" in $$source)) {
+            this["decoder"] = BodyDecoder.$zero;
+        }
+        if (!("protoDescriptor" in $$source)) {
+            this["protoDescriptor"] = "";
+        }
+        if (!("protoMessageType" in $$source)) {
+            this["protoMessageType"] = "";
+        }
+        if (!("avroSchema" in $$source)) {
+            this["avroSchema"] = "";
+        }
+        if (!("updatedAt" in $$source)) {
+            this["updatedAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DecoderConfig instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DecoderConfig {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new DecoderConfig($$parsedSource as Partial<DecoderConfig>);
+    }
+}
+
+/**
+ * DeleteGroupRequest 全集群删除消费者组请求
+ */
+export class DeleteGroupRequest {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 是否删除 %RETRY% 重试 Topic
+     */
+    "cleanRetryTopic": boolean;
+
+    /**
+     * 是否删除 %DLQ% 死信 Topic
+     */
+    "cleanDlqTopic": boolean;
+
+    /**
+     * 是否同时删除 Broker 上保存的消费位点
+     */
+    "cleanOffsets": boolean;
+
+    /**
+     * 有在线客户端时是否强制删除
+     */
+    "force": boolean;
+
+    /**
+     * 保护模式下需输入消费者组名称确认
+     */
+    "confirm": string;
+
+    /** Creates a new DeleteGroupRequest instance. */
+    constructor($$source: Partial<DeleteGroupRequest> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("cleanRetryTopic" in $$source)) {
+            this["cleanRetryTopic"] = false;
+        }
+        if (!("cleanDlqTopic" in $$source)) {
+            this["cleanDlqTopic"] = false;
+        }
+        if (!("cleanOffsets" in $$source)) {
+            this["cleanOffsets"] = false;
+        }
+        if (!("force" in $$source)) {
+            this["force"] = false;
+        }
+        if (!("confirm" in $$source)) {
+            this["confirm"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DeleteGroupRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DeleteGroupRequest {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new DeleteGroupRequest($$parsedSource as Partial<DeleteGroupRequest>);
+    }
+}
+
+/**
+ * DeleteGroupResult 全集群删除消费者组结果
+ */
+export class DeleteGroupResult {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 各 Broker 删除结果
+     */
+    "brokers": BrokerOperationResult[];
+
+    /**
+     * 重试/死信 Topic 清理结果
+     */
+    "topics": TopicCleanupResult[];
+
+    /** Creates a new DeleteGroupResult instance. */
+    constructor($$source: Partial<DeleteGroupResult> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("brokers" in $$source)) {
+            this["brokers"] = [];
+        }
+        if (!("topics" in $$source)) {
+            this["topics"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DeleteGroupResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DeleteGroupResult {
+        const $$createField1_0 = $$createType23;
+        const $$createField2_0 = $$createType25;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("brokers" in $$parsedSource) {
+            $$parsedSource["brokers"] = $$createField1_0($$parsedSource["brokers"]);
+        }
+        if ("topics" in $$parsedSource) {
+            $$parsedSource["topics"] = $$createField2_0($$parsedSource["topics"]);
+        }
+        return new DeleteGroupResult($$parsedSource as Partial<DeleteGroupResult>);
+    }
+}
+
+/**
+ * ExportFormat 消息导出文件格式
+ */
+export enum ExportFormat {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * 每行一条 JSON
+     */
+    ExportJSONL = "jsonl",
+
+    /**
+     * CSV，属性列为 JSON 字符串
+     */
+    ExportCSV = "csv",
+};
+
+/**
+ * FilterExpressionType 订阅过滤表达式类型
+ */
+export enum FilterExpressionType {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * Tag 表达式，如 TagA || TagB
+     */
+    FilterTag = "TAG",
+
+    /**
+     * SQL92 属性过滤表达式
+     */
+    FilterSQL92 = "SQL92",
+};
+
+/**
+ * FilterPropertyStat SQL92 表达式引用属性的出现情况
+ */
+export class FilterPropertyStat {
+    /**
+     * 属性名
+     */
+    "property": string;
+
+    /**
+     * 存在该属性的消息数
+     */
+    "present": number;
+
+    /**
+     * 缺少该属性的消息数
+     */
+    "missing": number;
+
+    /** Creates a new FilterPropertyStat instance. */
+    constructor($$source: Partial<FilterPropertyStat> = {}) {
+        if (!("property" in $$source)) {
+            this["property"] = "";
+        }
+        if (!("present" in $$source)) {
+            this["present"] = 0;
+        }
+        if (!("missing" in $$source)) {
+            this["missing"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new FilterPropertyStat instance from a string or object.
+     */
+    static createFrom($$source: any = {}): FilterPropertyStat {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new FilterPropertyStat($$parsedSource as Partial<FilterPropertyStat>);
+    }
+}
+
+/**
+ * FilterTagStat 按 Tag 统计的命中情况
+ */
+export class FilterTagStat {
+    /**
+     * 消息标签，空字符串表示无标签
+     */
+    "tag": string;
+
+    /**
+     * 消息数
+     */
+    "total": number;
+
+    /**
+     * 命中数
+     */
+    "matched": number;
+
+    /** Creates a new FilterTagStat instance. */
+    constructor($$source: Partial<FilterTagStat> = {}) {
+        if (!("tag" in $$source)) {
+            this["tag"] = "";
+        }
+        if (!("total" in $$source)) {
+            this["total"] = 0;
+        }
+        if (!("matched" in $$source)) {
+            this["matched"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new FilterTagStat instance from a string or object.
+     */
+    static createFrom($$source: any = {}): FilterTagStat {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new FilterTagStat($$parsedSource as Partial<FilterTagStat>);
+    }
+}
+
+/**
+ * FilteredMessage 单条消息的过滤结果
+ */
+export class FilteredMessage {
+    /**
+     * 消息信息
+     */
+    "message": MessageItem | null;
+
+    /**
+     * 是否会投递给订阅者
+     */
+    "matched": boolean;
+
+    /**
+     * 表达式结果：TRUE/FALSE/UNKNOWN
+     */
+    "result": string;
+
+    /**
+     * 未命中原因
+     */
+    "reason": string;
+
+    /** Creates a new FilteredMessage instance. */
+    constructor($$source: Partial<FilteredMessage> = {}) {
+        if (!("message" in $$source)) {
+            this["message"] = null;
+        }
+        if (!("matched" in $$source)) {
+            this["matched"] = false;
+        }
+        if (!("result" in $$source)) {
+            this["result"] = "";
+        }
+        if (!("reason" in $$source)) {
+            this["reason"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new FilteredMessage instance from a string or object.
+     */
+    static createFrom($$source: any = {}): FilteredMessage {
+        const $$createField0_0 = $$createType2;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("message" in $$parsedSource) {
+            $$parsedSource["message"] = $$createField0_0($$parsedSource["message"]);
+        }
+        return new FilteredMessage($$parsedSource as Partial<FilteredMessage>);
+    }
+}
+
+/**
+ * GroupBrokerItem 消费者组所在的 Broker
+ */
+export class GroupBrokerItem {
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * Master 地址
+     */
+    "brokerAddr": string;
+
+    /**
+     * 目标消费者组是否已存在
+     */
+    "exists": boolean;
+
+    /** Creates a new GroupBrokerItem instance. */
+    constructor($$source: Partial<GroupBrokerItem> = {}) {
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("brokerAddr" in $$source)) {
+            this["brokerAddr"] = "";
+        }
+        if (!("exists" in $$source)) {
+            this["exists"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new GroupBrokerItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): GroupBrokerItem {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new GroupBrokerItem($$parsedSource as Partial<GroupBrokerItem>);
+    }
+}
+
+/**
+ * GroupClient 消费者客户端信息
+ */
+export class GroupClient {
+    /**
+     * 客户端ID
+     */
+    "clientId": string;
+
+    /**
+     * IP 地址
+     */
+    "ip": string;
+
+    /**
+     * 客户端语言
+     */
+    "language": string;
+
+    /**
+     * 版本名称，如 V4_9_4
+     */
+    "version": string;
+
+    /**
+     * 客户端上报的原始版本号
+     */
+    "versionCode": number;
+
+    /**
+     * 是否低于推荐版本 rocketmq.MinRecommendedClientVersion，只判断 Java 客户端
+     */
+    "outdated": boolean;
+
+    /**
+     * 最后心跳时间，Broker 不提供心跳时间，暂不支持，始终为空
+     */
+    "lastHeartbeat"?: string;
+
+    /** Creates a new GroupClient instance. */
+    constructor($$source: Partial<GroupClient> = {}) {
+        if (!("clientId" in $$source)) {
+            this["clientId"] = "";
+        }
+        if (!("ip" in $$source)) {
+            this["ip"] = "";
+        }
+        if (!("language" in $$source)) {
+            this["language"] = "";
+        }
+        if (!("version" in $$source)) {
+            this["version"] = "";
+        }
+        if (!("versionCode" in $$source)) {
+            this["versionCode"] = 0;
+        }
+        if (!("outdated" in $$source)) {
+            this["outdated"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new GroupClient instance from a string or object.
+     */
+    static createFrom($$source: any = {}): GroupClient {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new GroupClient($$parsedSource as Partial<GroupClient>);
+    }
+}
+
+/**
+ * GroupHealth 消费者组健康状况
+ */
+export class GroupHealth {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 综合状态
+     */
+    "status": GroupStatus;
+
+    /**
+     * 检查结论
+     */
+    "findings": GroupHealthFinding[];
+
+    /**
+     * 检查时间
+     */
+    "checkedAt": string;
+
+    /** Creates a new GroupHealth instance. */
+    constructor($$source: Partial<GroupHealth> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("status" in $$source)) {
+            this["status"] = GroupStatus.$zero;
+        }
+        if (!("findings" in $$source)) {
+            this["findings"] = [];
+        }
+        if (!("checkedAt" in $$source)) {
+            this["checkedAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new GroupHealth instance from a string or object.
+     */
+    static createFrom($$source: any = {}): GroupHealth {
+        const $$createField2_0 = $$createType27;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("findings" in $$parsedSource) {
+            $$parsedSource["findings"] = $$createField2_0($$parsedSource["findings"]);
+        }
+        return new GroupHealth($$parsedSource as Partial<GroupHealth>);
+    }
+}
+
+/**
+ * GroupHealthFinding 消费者组健康检查结论
+ */
+export class GroupHealthFinding {
+    /**
+     * 级别
+     */
+    "level": HealthLevel;
+
+    /**
+     * 结论类型：stuckQueue 队列消费卡住，stuckDetectionError 检测出错
+     */
+    "type": string;
+
+    /**
+     * 描述
+     */
+    "message": string;
+
+    /**
+     * 相关的卡住队列
+     */
+    "stuckQueues": StuckQueue[];
+
+    /** Creates a new GroupHealthFinding instance. */
+    constructor($$source: Partial<GroupHealthFinding> = {}) {
+        if (!("level" in $$source)) {
+            this["level"] = HealthLevel.$zero;
+        }
+        if (!("type" in $$source)) {
+            this["type"] = "";
+        }
+        if (!("message" in $$source)) {
+            this["message"] = "";
+        }
+        if (!("stuckQueues" in $$source)) {
+            this["stuckQueues"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new GroupHealthFinding instance from a string or object.
+     */
+    static createFrom($$source: any = {}): GroupHealthFinding {
+        const $$createField3_0 = $$createType29;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("stuckQueues" in $$parsedSource) {
+            $$parsedSource["stuckQueues"] = $$createField3_0($$parsedSource["stuckQueues"]);
+        }
+        return new GroupHealthFinding($$parsedSource as Partial<GroupHealthFinding>);
+    }
+}
+
+/**
+ * GroupOffsetClonePlan 消费者组位点克隆预览
+ */
+export class GroupOffsetClonePlan {
+    /**
+     * 源消费者组
+     */
+    "sourceGroup": string;
+
+    /**
+     * 目标消费者组
+     */
+    "targetGroup": string;
+
+    /**
+     * 源消费者组所在的 Broker
+     */
+    "brokers": GroupBrokerItem[];
+
+    /**
+     * 各队列位点变化（基准为目标组当前位点）
+     */
+    "offsets": OffsetDiffItem[];
+
+    /** Creates a new GroupOffsetClonePlan instance. */
+    constructor($$source: Partial<GroupOffsetClonePlan> = {}) {
+        if (!("sourceGroup" in $$source)) {
+            this["sourceGroup"] = "";
+        }
+        if (!("targetGroup" in $$source)) {
+            this["targetGroup"] = "";
+        }
+        if (!("brokers" in $$source)) {
+            this["brokers"] = [];
+        }
+        if (!("offsets" in $$source)) {
+            this["offsets"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new GroupOffsetClonePlan instance from a string or object.
+     */
+    static createFrom($$source: any = {}): GroupOffsetClonePlan {
+        const $$createField2_0 = $$createType31;
+        const $$createField3_0 = $$createType33;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("brokers" in $$parsedSource) {
+            $$parsedSource["brokers"] = $$createField2_0($$parsedSource["brokers"]);
+        }
+        if ("offsets" in $$parsedSource) {
+            $$parsedSource["offsets"] = $$createField3_0($$parsedSource["offsets"]);
+        }
+        return new GroupOffsetClonePlan($$parsedSource as Partial<GroupOffsetClonePlan>);
+    }
+}
+
+/**
+ * GroupOffsetCloneResult 消费者组位点克隆结果
+ */
+export class GroupOffsetCloneResult {
+    /**
+     * 源消费者组
+     */
+    "sourceGroup": string;
+
+    /**
+     * 目标消费者组
+     */
+    "targetGroup": string;
+
+    /**
+     * 新建目标消费者组的 Broker
+     */
+    "createdBrokers": string[];
+
+    /**
+     * 创建目标消费者组失败的 Broker，其上的队列不写入位点
+     */
+    "failedBrokers": string[];
+
+    /**
+     * 创建消费者组时的错误
+     */
+    "errors": string[];
+
+    /**
+     * 各队列位点写入结果
+     */
+    "offsets": OffsetUpdateResult[];
+
+    /** Creates a new GroupOffsetCloneResult instance. */
+    constructor($$source: Partial<GroupOffsetCloneResult> = {}) {
+        if (!("sourceGroup" in $$source)) {
+            this["sourceGroup"] = "";
+        }
+        if (!("targetGroup" in $$source)) {
+            this["targetGroup"] = "";
+        }
+        if (!("createdBrokers" in $$source)) {
+            this["createdBrokers"] = [];
+        }
+        if (!("failedBrokers" in $$source)) {
+            this["failedBrokers"] = [];
+        }
+        if (!("errors" in $$source)) {
+            this["errors"] = [];
+        }
+        if (!("offsets" in $$source)) {
+            this["offsets"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new GroupOffsetCloneResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): GroupOffsetCloneResult {
+        const $$createField2_0 = $$createType0;
+        const $$createField3_0 = $$createType0;
+        const $$createField4_0 = $$createType0;
+        const $$createField5_0 = $$createType35;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("createdBrokers" in $$parsedSource) {
+            $$parsedSource["createdBrokers"] = $$createField2_0($$parsedSource["createdBrokers"]);
+        }
+        if ("failedBrokers" in $$parsedSource) {
+            $$parsedSource["failedBrokers"] = $$createField3_0($$parsedSource["failedBrokers"]);
+        }
+        if ("errors" in $$parsedSource) {
+            $$parsedSource["errors"] = $$createField4_0($$parsedSource["errors"]);
+        }
+        if ("offsets" in $$parsedSource) {
+            $$parsedSource["offsets"] = $$createField5_0($$parsedSource["offsets"]);
+        }
+        return new GroupOffsetCloneResult($$parsedSource as Partial<GroupOffsetCloneResult>);
+    }
+}
+
+/**
+ * GroupStatus 消费者组状态
+ */
+export enum GroupStatus {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    GroupOnline = "online",
+    GroupWarning = "warning",
+    GroupOffline = "offline",
+};
+
+/**
+ * GroupSubscription 订阅关系
+ */
+export class GroupSubscription {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 过滤表达式
+     */
+    "expression": string;
+
+    /**
+     * 表达式类型(TAG/SQL92)
+     */
+    "expressionType": string;
+
+    /**
+     * 消费 TPS
+     */
+    "consumeTps": number;
+
+    /** Creates a new GroupSubscription instance. */
+    constructor($$source: Partial<GroupSubscription> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("expression" in $$source)) {
+            this["expression"] = "";
+        }
+        if (!("expressionType" in $$source)) {
+            this["expressionType"] = "";
+        }
+        if (!("consumeTps" in $$source)) {
+            this["consumeTps"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new GroupSubscription instance from a string or object.
+     */
+    static createFrom($$source: any = {}): GroupSubscription {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new GroupSubscription($$parsedSource as Partial<GroupSubscription>);
+    }
+}
+
+/**
+ * HealthLevel 健康检查结论级别
+ */
+export enum HealthLevel {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    HealthInfo = "info",
+    HealthWarning = "warning",
+    HealthCritical = "critical",
+};
+
+/**
+ * MessageExportRequest 消息导出请求，TimeQuery、Browse、Messages 三选一
+ */
+export class MessageExportRequest {
+    /**
+     * 导出格式
+     */
+    "format": ExportFormat;
+
+    /**
+     * 导出文件路径，为空时写入应用数据目录
+     */
+    "filePath": string;
+
+    /**
+     * 按时间范围查询的全部结果（不分页）
+     */
+    "timeQuery": MessageQueryParams | null;
+
+    /**
+     * 按队列位点浏览的一页结果
+     */
+    "browse": QueueBrowseParams | null;
+
+    /**
+     * 前端已有的消息列表
+     */
+    "messages": (MessageItem | null)[];
+
+    /** Creates a new MessageExportRequest instance. */
+    constructor($$source: Partial<MessageExportRequest> = {}) {
+        if (!("format" in $$source)) {
+            this["format"] = ExportFormat.$zero;
+        }
+        if (!("filePath" in $$source)) {
+            this["filePath"] = "";
+        }
+        if (!("timeQuery" in $$source)) {
+            this["timeQuery"] = null;
+        }
+        if (!("browse" in $$source)) {
+            this["browse"] = null;
+        }
+        if (!("messages" in $$source)) {
+            this["messages"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageExportRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageExportRequest {
+        const $$createField2_0 = $$createType37;
+        const $$createField3_0 = $$createType39;
+        const $$createField4_0 = $$createType3;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("timeQuery" in $$parsedSource) {
+            $$parsedSource["timeQuery"] = $$createField2_0($$parsedSource["timeQuery"]);
+        }
+        if ("browse" in $$parsedSource) {
+            $$parsedSource["browse"] = $$createField3_0($$parsedSource["browse"]);
+        }
+        if ("messages" in $$parsedSource) {
+            $$parsedSource["messages"] = $$createField4_0($$parsedSource["messages"]);
+        }
+        return new MessageExportRequest($$parsedSource as Partial<MessageExportRequest>);
+    }
+}
+
+/**
+ * MessageExportResult 消息导出结果
+ */
+export class MessageExportResult {
+    /**
+     * 导出文件路径
+     */
+    "filePath": string;
+
+    /**
+     * 导出格式
+     */
+    "format": ExportFormat;
+
+    /**
+     * 导出消息数
+     */
+    "count": number;
+
+    /**
+     * 以 base64 导出的二进制消息数
+     */
+    "binaryCount": number;
+
+    /**
+     * 消息体未能解压、仍为压缩数据的消息数，这些消息不能回放
+     */
+    "compressedCount": number;
+
+    /**
+     * 时间查询结果超过上限，只导出了最新的部分消息
+     */
+    "truncated": boolean;
+
+    /** Creates a new MessageExportResult instance. */
+    constructor($$source: Partial<MessageExportResult> = {}) {
+        if (!("filePath" in $$source)) {
+            this["filePath"] = "";
+        }
+        if (!("format" in $$source)) {
+            this["format"] = ExportFormat.$zero;
+        }
+        if (!("count" in $$source)) {
+            this["count"] = 0;
+        }
+        if (!("binaryCount" in $$source)) {
+            this["binaryCount"] = 0;
+        }
+        if (!("compressedCount" in $$source)) {
+            this["compressedCount"] = 0;
+        }
+        if (!("truncated" in $$source)) {
+            this["truncated"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageExportResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageExportResult {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new MessageExportResult($$parsedSource as Partial<MessageExportResult>);
+    }
+}
+
+/**
+ * MessageFilterRequest 消息过滤预览请求
+ * BrokerName 不为空时按单个队列的位点区间扫描，否则按时间范围扫描 Topic 全部队列。
+ */
+export class MessageFilterRequest {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 表达式类型
+     */
+    "expressionType": FilterExpressionType;
+
+    /**
+     * 过滤表达式
+     */
+    "expression": string;
+
+    /**
+     * 开始时间戳(毫秒)，0 表示不限
+     */
+    "startTime": number;
+
+    /**
+     * 结束时间戳(毫秒)，0 表示不限
+     */
+    "endTime": number;
+
+    /**
+     * 按位点扫描的 Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 按位点扫描的队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 起始位点，<0 表示队列最小位点
+     */
+    "startOffset": number;
+
+    /**
+     * 结束位点（不含），<=0 表示队列最大位点
+     */
+    "endOffset": number;
+
+    /**
+     * 命中与未命中消息各最多返回条数
+     */
+    "maxResults": number;
+
+    /**
+     * 最多扫描的消息数
+     */
+    "scanLimit": number;
+
+    /** Creates a new MessageFilterRequest instance. */
+    constructor($$source: Partial<MessageFilterRequest> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("expressionType" in $$source)) {
+            this["expressionType"] = FilterExpressionType.$zero;
+        }
+        if (!("expression" in $$source)) {
+            this["expression"] = "";
+        }
+        if (!("startTime" in $$source)) {
+            this["startTime"] = 0;
+        }
+        if (!("endTime" in $$source)) {
+            this["endTime"] = 0;
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("startOffset" in $$source)) {
+            this["startOffset"] = 0;
+        }
+        if (!("endOffset" in $$source)) {
+            this["endOffset"] = 0;
+        }
+        if (!("maxResults" in $$source)) {
+            this["maxResults"] = 0;
+        }
+        if (!("scanLimit" in $$source)) {
+            this["scanLimit"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageFilterRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageFilterRequest {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new MessageFilterRequest($$parsedSource as Partial<MessageFilterRequest>);
+    }
+}
+
+/**
+ * MessageFilterResult 消息过滤预览结果
+ */
+export class MessageFilterResult {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 表达式类型
+     */
+    "expressionType": FilterExpressionType;
+
+    /**
+     * 过滤表达式
+     */
+    "expression": string;
+
+    /**
+     * 解析出的订阅标签，为空表示订阅全部
+     */
+    "tags": string[];
+
+    /**
+     * SQL92 表达式引用的属性
+     */
+    "properties": string[];
+
+    /**
+     * 已扫描消息数
+     */
+    "scanned": number;
+
+    /**
+     * 命中数
+     */
+    "matched": number;
+
+    /**
+     * 未命中数（含 UNKNOWN）
+     */
+    "unmatched": number;
+
+    /**
+     * 表达式结果为 UNKNOWN 的消息数
+     */
+    "unknown": number;
+
+    /**
+     * 命中率(%)
+     */
+    "matchRate": number;
+
+    /**
+     * 命中的消息
+     */
+    "matches": FilteredMessage[];
+
+    /**
+     * 未命中的消息及原因
+     */
+    "mismatches": FilteredMessage[];
+
+    /**
+     * 按 Tag 统计
+     */
+    "tagStats": FilterTagStat[];
+
+    /**
+     * 引用属性的出现情况
+     */
+    "propertyStats": FilterPropertyStat[];
+
+    /**
+     * 是否因达到扫描上限被截断
+     */
+    "truncated": boolean;
+
+    /** Creates a new MessageFilterResult instance. */
+    constructor($$source: Partial<MessageFilterResult> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("expressionType" in $$source)) {
+            this["expressionType"] = FilterExpressionType.$zero;
+        }
+        if (!("expression" in $$source)) {
+            this["expression"] = "";
+        }
+        if (!("tags" in $$source)) {
+            this["tags"] = [];
+        }
+        if (!("properties" in $$source)) {
+            this["properties"] = [];
+        }
+        if (!("scanned" in $$source)) {
+            this["scanned"] = 0;
+        }
+        if (!("matched" in $$source)) {
+            this["matched"] = 0;
+        }
+        if (!("unmatched" in $$source)) {
+            this["unmatched"] = 0;
+        }
+        if (!("unknown" in $$source)) {
+            this["unknown"] = 0;
+        }
+        if (!("matchRate" in $$source)) {
+            this["matchRate"] = 0;
+        }
+        if (!("matches" in $$source)) {
+            this["matches"] = [];
+        }
+        if (!("mismatches" in $$source)) {
+            this["mismatches"] = [];
+        }
+        if (!("tagStats" in $$source)) {
+            this["tagStats"] = [];
+        }
+        if (!("propertyStats" in $$source)) {
+            this["propertyStats"] = [];
+        }
+        if (!("truncated" in $$source)) {
+            this["truncated"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageFilterResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageFilterResult {
+        const $$createField3_0 = $$createType0;
+        const $$createField4_0 = $$createType0;
+        const $$createField10_0 = $$createType41;
+        const $$createField11_0 = $$createType41;
+        const $$createField12_0 = $$createType43;
+        const $$createField13_0 = $$createType45;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("tags" in $$parsedSource) {
+            $$parsedSource["tags"] = $$createField3_0($$parsedSource["tags"]);
+        }
+        if ("properties" in $$parsedSource) {
+            $$parsedSource["properties"] = $$createField4_0($$parsedSource["properties"]);
+        }
+        if ("matches" in $$parsedSource) {
+            $$parsedSource["matches"] = $$createField10_0($$parsedSource["matches"]);
+        }
+        if ("mismatches" in $$parsedSource) {
+            $$parsedSource["mismatches"] = $$createField11_0($$parsedSource["mismatches"]);
+        }
+        if ("tagStats" in $$parsedSource) {
+            $$parsedSource["tagStats"] = $$createField12_0($$parsedSource["tagStats"]);
+        }
+        if ("propertyStats" in $$parsedSource) {
+            $$parsedSource["propertyStats"] = $$createField13_0($$parsedSource["propertyStats"]);
+        }
+        return new MessageFilterResult($$parsedSource as Partial<MessageFilterResult>);
+    }
+}
+
+/**
+ * MessageIDInfo 消息ID解析结果
+ */
+export class MessageIDInfo {
+    /**
+     * 输入的消息ID
+     */
+    "input": string;
+
+    /**
+     * 消息ID类型
+     */
+    "type": MessageIDType;
+
+    /**
+     * 存储节点（偏移消息ID）
+     */
+    "storeHost": string;
+
+    /**
+     * CommitLog 物理偏移（偏移消息ID）
+     */
+    "commitLogOffset": number;
+
+    /**
+     * 生产者 IP（唯一键）
+     */
+    "clientIp": string;
+
+    /**
+     * 生产者 MAC 地址（5.x 消息ID）
+     */
+    "mac": string;
+
+    /**
+     * 生产者进程号（低 16 位）
+     */
+    "pid": number;
+
+    /**
+     * 生产者 ClassLoader 哈希（唯一键）
+     */
+    "classLoaderHash": string;
+
+    /**
+     * 推算的生产时间戳(毫秒)
+     */
+    "timestamp": number;
+
+    /**
+     * 推算的生产时间
+     */
+    "time": string;
+
+    /**
+     * 生产者内的自增计数器/序号
+     */
+    "counter": number;
+
+    /**
+     * 解析说明
+     */
+    "notes": string[];
+
+    /** Creates a new MessageIDInfo instance. */
+    constructor($$source: Partial<MessageIDInfo> = {}) {
+        if (!("input" in $$source)) {
+            this["input"] = "";
+        }
+        if (!("type" in $$source)) {
+            this["type"] = MessageIDType.$zero;
+        }
+        if (!("storeHost" in $$source)) {
+            this["storeHost"] = "";
+        }
+        if (!("commitLogOffset" in $$source)) {
+            this["commitLogOffset"] = 0;
+        }
+        if (!("clientIp" in $$source)) {
+            this["clientIp"] = "";
+        }
+        if (!("mac" in $$source)) {
+            this["mac"] = "";
+        }
+        if (!("pid" in $$source)) {
+            this["pid"] = 0;
+        }
+        if (!("classLoaderHash" in $$source)) {
+            this["classLoaderHash"] = "";
+        }
+        if (!("timestamp" in $$source)) {
+            this["timestamp"] = 0;
+        }
+        if (!("time" in $$source)) {
+            this["time"] = "";
+        }
+        if (!("counter" in $$source)) {
+            this["counter"] = 0;
+        }
+        if (!("notes" in $$source)) {
+            this["notes"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageIDInfo instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageIDInfo {
+        const $$createField11_0 = $$createType0;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("notes" in $$parsedSource) {
+            $$parsedSource["notes"] = $$createField11_0($$parsedSource["notes"]);
+        }
+        return new MessageIDInfo($$parsedSource as Partial<MessageIDInfo>);
+    }
+}
+
+/**
+ * MessageIDType 消息ID类型
+ */
+export enum MessageIDType {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * 偏移消息ID（Broker 生成，编码存储节点与 CommitLog 偏移）
+     */
+    MessageIDOffset = "offset",
+
+    /**
+     * 唯一键 UNIQ_KEY（4.x 客户端生成，编码 IP、PID、时间与计数器）
+     */
+    MessageIDUnique = "unique",
+
+    /**
+     * 5.x 客户端消息ID（编码 MAC、PID、时间与序号）
+     */
+    MessageIDV5 = "v5",
+
+    /**
+     * 无法识别
+     */
+    MessageIDUnknown = "unknown",
+};
+
+/**
+ * MessageItem 消息信息
+ */
+export class MessageItem {
+    /**
+     * 消息序号
+     */
+    "id": number;
+
+    /**
+     * 所属集群
+     */
+    "cluster": string;
+
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 消息ID
+     */
+    "messageId": string;
+
+    /**
+     * 消息标签
+     */
+    "tags": string;
+
+    /**
+     * 消息Keys
+     */
+    "keys": string;
+
+    /**
+     * 生产者组
+     */
+    "producerGroup": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 队列偏移
+     */
+    "queueOffset": number;
+
+    /**
+     * 存储节点
+     */
+    "storeHost": string;
+
+    /**
+     * 生产节点
+     */
+    "bornHost": string;
+
+    /**
+     * 存储时间
+     */
+    "storeTime": string;
+
+    /**
+     * 存储时间戳
+     */
+    "storeTimestamp": number;
+
+    /**
+     * 消息状态
+     */
+    "status": MessageStatus;
+
+    /**
+     * 重试次数
+     */
+    "retryTimes": number;
+
+    /**
+     * 原始 Topic（重试/死信消息）
+     */
+    "originTopic": string;
+
+    /**
+     * 原始消息ID（重试/死信消息）
+     */
+    "originMsgId": string;
+
+    /**
+     * 消息体
+     */
+    "body": string;
+
+    /**
+     * 消息体编码
+     */
+    "bodyEncoding": BodyEncoding;
+
+    /**
+     * 解压后的消息体字节数
+     */
+    "bodySize": number;
+
+    /**
+     * 消息体压缩算法（zlib/lz4/zstd），未压缩时为空
+     */
+    "compression": string;
+
+    /**
+     * Body 仍是压缩数据：解压失败时保留原始数据
+     */
+    "compressed": boolean;
+
+    /**
+     * Topic 记住的解码器，未配置时为空；解码结果通过 DecodeMessageBody 按需获取
+     */
+    "decoder": BodyDecoder;
+
+    /**
+     * 消息属性
+     */
+    "properties": { [_ in string]?: string };
+
+    /** Creates a new MessageItem instance. */
+    constructor($$source: Partial<MessageItem> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = 0;
+        }
+        if (!("cluster" in $$source)) {
+            this["cluster"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("messageId" in $$source)) {
+            this["messageId"] = "";
+        }
+        if (!("tags" in $$source)) {
+            this["tags"] = "";
+        }
+        if (!("keys" in $$source)) {
+            this["keys"] = "";
+        }
+        if (!("producerGroup" in $$source)) {
+            this["producerGroup"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("queueOffset" in $$source)) {
+            this["queueOffset"] = 0;
+        }
+        if (!("storeHost" in $$source)) {
+            this["storeHost"] = "";
+        }
+        if (!("bornHost" in $$source)) {
+            this["bornHost"] = "";
+        }
+        if (!("storeTime" in $$source)) {
+            this["storeTime"] = "";
+        }
+        if (!("storeTimestamp" in $$source)) {
+            this["storeTimestamp"] = 0;
+        }
+        if (!("status" in $$source)) {
+            this["status"] = MessageStatus.$zero;
+        }
+        if (!("retryTimes" in $$source)) {
+            this["retryTimes"] = 0;
+        }
+        if (!("originTopic" in $$source)) {
+            this["originTopic"] = "";
+        }
+        if (!("originMsgId" in $$source)) {
+            this["originMsgId"] = "";
+        }
+        if (!("body" in $$source)) {
+            this["body"] = "";
+        }
+        if (!("bodyEncoding" in $$source)) {
+            this["bodyEncoding"] = BodyEncoding.$zero;
+        }
+        if (!("bodySize" in $$source)) {
+            this["bodySize"] = 0;
+        }
+        if (!("compression" in $$source)) {
+            this["compression"] = "";
+        }
+        if (!("compressed" in $$source)) {
+            this["compressed"] = false;
+        }
+        if (!("decoder" in $$source)) {
+            this["decoder"] = BodyDecoder.$zero;
+        }
+        if (!("properties" in $$source)) {
+            this["properties"] = {};
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageItem {
+        const $$createField23_0 = $$createType10;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("properties" in $$parsedSource) {
+            $$parsedSource["properties"] = $$createField23_0($$parsedSource["properties"]);
+        }
+        return new MessageItem($$parsedSource as Partial<MessageItem>);
+    }
+}
+
+/**
+ * MessagePage 分页消息结果
+ */
+export class MessagePage {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 当前页消息
+     */
+    "messages": (MessageItem | null)[];
+
+    /**
+     * 可翻页的消息总数（不超过上限）
+     */
+    "total": number;
+
+    /**
+     * 时间范围内的消息估算总数
+     */
+    "inRange": number;
+
+    /**
+     * 当前页码
+     */
+    "pageNum": number;
+
+    /**
+     * 每页数量
+     */
+    "pageSize": number;
+
+    /**
+     * 是否因达到上限只保留了最新的消息
+     */
+    "truncated": boolean;
+
+    /**
+     * 查询ID，翻页时传回
+     */
+    "queryId": string;
+
+    /** Creates a new MessagePage instance. */
+    constructor($$source: Partial<MessagePage> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("messages" in $$source)) {
+            this["messages"] = [];
+        }
+        if (!("total" in $$source)) {
+            this["total"] = 0;
+        }
+        if (!("inRange" in $$source)) {
+            this["inRange"] = 0;
+        }
+        if (!("pageNum" in $$source)) {
+            this["pageNum"] = 0;
+        }
+        if (!("pageSize" in $$source)) {
+            this["pageSize"] = 0;
+        }
+        if (!("truncated" in $$source)) {
+            this["truncated"] = false;
+        }
+        if (!("queryId" in $$source)) {
+            this["queryId"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessagePage instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessagePage {
+        const $$createField1_0 = $$createType3;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("messages" in $$parsedSource) {
+            $$parsedSource["messages"] = $$createField1_0($$parsedSource["messages"]);
+        }
+        return new MessagePage($$parsedSource as Partial<MessagePage>);
+    }
+}
+
+/**
+ * MessageQueryParams 消息查询参数
+ */
+export class MessageQueryParams {
+    /**
+     * 集群名称
+     */
+    "cluster": string;
+
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 消息ID
+     */
+    "messageId": string;
+
+    /**
+     * 消息Key
+     */
+    "messageKey": string;
+
+    /**
+     * 开始时间戳(毫秒)
+     */
+    "startTime": number;
+
+    /**
+     * 结束时间戳(毫秒)，0 表示当前时间
+     */
+    "endTime": number;
+
+    /**
+     * 最大返回数量（按时间查询时为按存储时间从新到旧保留的消息总数）
+     */
+    "maxResults": number;
+
+    /**
+     * 页码，从 1 开始
+     */
+    "pageNum": number;
+
+    /**
+     * 每页数量
+     */
+    "pageSize": number;
+
+    /**
+     * 上一页返回的查询ID，翻页时传回以复用已拉取的结果
+     */
+    "queryId": string;
+
+    /** Creates a new MessageQueryParams instance. */
+    constructor($$source: Partial<MessageQueryParams> = {}) {
+        if (!("cluster" in $$source)) {
+            this["cluster"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("messageId" in $$source)) {
+            this["messageId"] = "";
+        }
+        if (!("messageKey" in $$source)) {
+            this["messageKey"] = "";
+        }
+        if (!("startTime" in $$source)) {
+            this["startTime"] = 0;
+        }
+        if (!("endTime" in $$source)) {
+            this["endTime"] = 0;
+        }
+        if (!("maxResults" in $$source)) {
+            this["maxResults"] = 0;
+        }
+        if (!("pageNum" in $$source)) {
+            this["pageNum"] = 0;
+        }
+        if (!("pageSize" in $$source)) {
+            this["pageSize"] = 0;
+        }
+        if (!("queryId" in $$source)) {
+            this["queryId"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageQueryParams instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageQueryParams {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new MessageQueryParams($$parsedSource as Partial<MessageQueryParams>);
+    }
+}
+
+/**
+ * MessageReplayItem 单条消息回放结果
+ */
+export class MessageReplayItem {
+    /**
+     * 文件中的序号（从 1 开始）
+     */
+    "line": number;
+
+    /**
+     * 原消息ID
+     */
+    "sourceMsgId": string;
+
+    /**
+     * 目标 Topic
+     */
+    "targetTopic": string;
+
+    /**
+     * 发送的消息标签
+     */
+    "tags": string;
+
+    /**
+     * 发送的消息Keys
+     */
+    "keys": string;
+
+    /**
+     * 发送的用户属性
+     */
+    "properties": { [_ in string]?: string };
+
+    /**
+     * 消息体字节数
+     */
+    "bodySize": number;
+
+    /**
+     * 新消息ID
+     */
+    "newMessageId": string;
+
+    /**
+     * 是否成功（试运行时表示构造成功）
+     */
+    "success": boolean;
+
+    /**
+     * 错误信息
+     */
+    "error": string;
+
+    /**
+     * 处理时间
+     */
+    "sentAt": string;
+
+    /** Creates a new MessageReplayItem instance. */
+    constructor($$source: Partial<MessageReplayItem> = {}) {
+        if (!("line" in $$source)) {
+            this["line"] = 0;
+        }
+        if (!("sourceMsgId" in $$source)) {
+            this["sourceMsgId"] = "";
+        }
+        if (!("targetTopic" in $$source)) {
+            this["targetTopic"] = "";
+        }
+        if (!("tags" in $$source)) {
+            this["tags"] = "";
+        }
+        if (!("keys" in $$source)) {
+            this["keys"] = "";
+        }
+        if (!("properties" in $$source)) {
+            this["properties"] = {};
+        }
+        if (!("bodySize" in $$source)) {
+            this["bodySize"] = 0;
+        }
+        if (!("newMessageId" in $$source)) {
+            this["newMessageId"] = "";
+        }
+        if (!("success" in $$source)) {
+            this["success"] = false;
+        }
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+        if (!("sentAt" in $$source)) {
+            this["sentAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageReplayItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageReplayItem {
+        const $$createField5_0 = $$createType10;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("properties" in $$parsedSource) {
+            $$parsedSource["properties"] = $$createField5_0($$parsedSource["properties"]);
+        }
+        return new MessageReplayItem($$parsedSource as Partial<MessageReplayItem>);
+    }
+}
+
+/**
+ * MessageReplayItemsEvent 消息回放推送的一批逐条结果
+ */
+export class MessageReplayItemsEvent {
+    /**
+     * 回放ID
+     */
+    "replayId": string;
+
+    /**
+     * 逐条结果
+     */
+    "items": MessageReplayItem[];
+
+    /** Creates a new MessageReplayItemsEvent instance. */
+    constructor($$source: Partial<MessageReplayItemsEvent> = {}) {
+        if (!("replayId" in $$source)) {
+            this["replayId"] = "";
+        }
+        if (!("items" in $$source)) {
+            this["items"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageReplayItemsEvent instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageReplayItemsEvent {
+        const $$createField1_0 = $$createType47;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("items" in $$parsedSource) {
+            $$parsedSource["items"] = $$createField1_0($$parsedSource["items"]);
+        }
+        return new MessageReplayItemsEvent($$parsedSource as Partial<MessageReplayItemsEvent>);
+    }
+}
+
+/**
+ * MessageReplayProgress 消息回放进度
+ */
+export class MessageReplayProgress {
+    /**
+     * 回放ID
+     */
+    "replayId": string;
+
+    /**
+     * 回放文件路径
+     */
+    "filePath": string;
+
+    /**
+     * 目标连接的 NameServer
+     */
+    "nameServer": string;
+
+    /**
+     * 目标 Topic
+     */
+    "targetTopic": string;
+
+    /**
+     * 是否试运行
+     */
+    "dryRun": boolean;
+
+    /**
+     * 待回放消息数
+     */
+    "total": number;
+
+    /**
+     * 已处理消息数
+     */
+    "processed": number;
+
+    /**
+     * 成功数
+     */
+    "succeeded": number;
+
+    /**
+     * 失败数
+     */
+    "failed": number;
+
+    /**
+     * 是否运行中
+     */
+    "running": boolean;
+
+    /**
+     * 是否被取消
+     */
+    "cancelled": boolean;
+
+    /**
+     * 中止回放的错误信息（单条失败记录在明细中）
+     */
+    "error": string;
+
+    /**
+     * 逐条结果日志文件（JSONL）
+     */
+    "logPath": string;
+
+    /**
+     * 开始时间
+     */
+    "startedAt": string;
+
+    /**
+     * 已耗时(毫秒)
+     */
+    "elapsedMillis": number;
+
+    /** Creates a new MessageReplayProgress instance. */
+    constructor($$source: Partial<MessageReplayProgress> = {}) {
+        if (!("replayId" in $$source)) {
+            this["replayId"] = "";
+        }
+        if (!("filePath" in $$source)) {
+            this["filePath"] = "";
+        }
+        if (!("nameServer" in $$source)) {
+            this["nameServer"] = "";
+        }
+        if (!("targetTopic" in $$source)) {
+            this["targetTopic"] = "";
+        }
+        if (!("dryRun" in $$source)) {
+            this["dryRun"] = false;
+        }
+        if (!("total" in $$source)) {
+            this["total"] = 0;
+        }
+        if (!("processed" in $$source)) {
+            this["processed"] = 0;
+        }
+        if (!("succeeded" in $$source)) {
+            this["succeeded"] = 0;
+        }
+        if (!("failed" in $$source)) {
+            this["failed"] = 0;
+        }
+        if (!("running" in $$source)) {
+            this["running"] = false;
+        }
+        if (!("cancelled" in $$source)) {
+            this["cancelled"] = false;
+        }
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+        if (!("logPath" in $$source)) {
+            this["logPath"] = "";
+        }
+        if (!("startedAt" in $$source)) {
+            this["startedAt"] = "";
+        }
+        if (!("elapsedMillis" in $$source)) {
+            this["elapsedMillis"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageReplayProgress instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageReplayProgress {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new MessageReplayProgress($$parsedSource as Partial<MessageReplayProgress>);
+    }
+}
+
+/**
+ * MessageReplayRequest 消息回放请求
+ */
+export class MessageReplayRequest {
+    /**
+     * 导出文件路径（.csv 按 CSV 解析，其余按 JSONL）
+     */
+    "filePath": string;
+
+    /**
+     * 目标连接的 NameServer，为空时使用默认连接（需已连接）
+     */
+    "nameServer": string;
+
+    /**
+     * 目标 Topic
+     */
+    "targetTopic": string;
+
+    /**
+     * 替换消息标签，为空时保留原标签
+     */
+    "tags": string;
+
+    /**
+     * 替换消息Keys，为空时保留原Keys
+     */
+    "keys": string[];
+
+    /**
+     * 新增或覆盖的属性
+     */
+    "properties": { [_ in string]?: string };
+
+    /**
+     * 删除的属性
+     */
+    "removeProperties": string[];
+
+    /**
+     * 每秒最多发送条数，<=0 表示默认值
+     */
+    "ratePerSecond": number;
+
+    /**
+     * 最多回放条数，<=0 表示全部
+     */
+    "limit": number;
+
+    /**
+     * 只解析和构造消息，不发送
+     */
+    "dryRun": boolean;
+
+    /**
+     * 目标连接处于保护模式时需输入目标 Topic 确认
+     */
+    "confirm": string;
+
+    /** Creates a new MessageReplayRequest instance. */
+    constructor($$source: Partial<MessageReplayRequest> = {}) {
+        if (!("filePath" in $$source)) {
+            this["filePath"] = "";
+        }
+        if (!("nameServer" in $$source)) {
+            this["nameServer"] = "";
+        }
+        if (!("targetTopic" in $$source)) {
+            this["targetTopic"] = "";
+        }
+        if (!("tags" in $$source)) {
+            this["tags"] = "";
+        }
+        if (!("keys" in $$source)) {
+            this["keys"] = [];
+        }
+        if (!("properties" in $$source)) {
+            this["properties"] = {};
+        }
+        if (!("removeProperties" in $$source)) {
+            this["removeProperties"] = [];
+        }
+        if (!("ratePerSecond" in $$source)) {
+            this["ratePerSecond"] = 0;
+        }
+        if (!("limit" in $$source)) {
+            this["limit"] = 0;
+        }
+        if (!("dryRun" in $$source)) {
+            this["dryRun"] = false;
+        }
+        if (!("confirm" in $$source)) {
+            this["confirm"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageReplayRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageReplayRequest {
+        const $$createField4_0 = $$createType0;
+        const $$createField5_0 = $$createType10;
+        const $$createField6_0 = $$createType0;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("keys" in $$parsedSource) {
+            $$parsedSource["keys"] = $$createField4_0($$parsedSource["keys"]);
+        }
+        if ("properties" in $$parsedSource) {
+            $$parsedSource["properties"] = $$createField5_0($$parsedSource["properties"]);
+        }
+        if ("removeProperties" in $$parsedSource) {
+            $$parsedSource["removeProperties"] = $$createField6_0($$parsedSource["removeProperties"]);
+        }
+        return new MessageReplayRequest($$parsedSource as Partial<MessageReplayRequest>);
+    }
+}
+
+/**
+ * MessageStatus 消息状态
+ */
+export enum MessageStatus {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    MsgNormal = "normal",
+    MsgRetry = "retry",
+    MsgDLQ = "dlq",
+};
+
+/**
+ * MessageTrace 单条消息的轨迹
+ */
+export class MessageTrace {
+    /**
+     * 消息ID
+     */
+    "msgId": string;
+
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 消息标签
+     */
+    "tags": string;
+
+    /**
+     * 消息Keys
+     */
+    "keys": string;
+
+    /**
+     * 存储节点
+     */
+    "storeHost": string;
+
+    /**
+     * 各组时间线，生产者在前
+     */
+    "groups": TraceGroupTimeline[];
+
+    /** Creates a new MessageTrace instance. */
+    constructor($$source: Partial<MessageTrace> = {}) {
+        if (!("msgId" in $$source)) {
+            this["msgId"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("tags" in $$source)) {
+            this["tags"] = "";
+        }
+        if (!("keys" in $$source)) {
+            this["keys"] = "";
+        }
+        if (!("storeHost" in $$source)) {
+            this["storeHost"] = "";
+        }
+        if (!("groups" in $$source)) {
+            this["groups"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageTrace instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageTrace {
+        const $$createField5_0 = $$createType49;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("groups" in $$parsedSource) {
+            $$parsedSource["groups"] = $$createField5_0($$parsedSource["groups"]);
+        }
+        return new MessageTrace($$parsedSource as Partial<MessageTrace>);
+    }
+}
+
+/**
+ * MessageTraceQuery 消息轨迹查询参数
+ */
+export class MessageTraceQuery {
+    /**
+     * 消息ID，与 Key 二选一
+     */
+    "msgId": string;
+
+    /**
+     * 消息Key
+     */
+    "key": string;
+
+    /**
+     * 轨迹 Topic，为空时使用 RMQ_SYS_TRACE_TOPIC
+     */
+    "traceTopic": string;
+
+    /**
+     * 最多读取的轨迹消息数
+     */
+    "maxResults": number;
+
+    /** Creates a new MessageTraceQuery instance. */
+    constructor($$source: Partial<MessageTraceQuery> = {}) {
+        if (!("msgId" in $$source)) {
+            this["msgId"] = "";
+        }
+        if (!("key" in $$source)) {
+            this["key"] = "";
+        }
+        if (!("traceTopic" in $$source)) {
+            this["traceTopic"] = "";
+        }
+        if (!("maxResults" in $$source)) {
+            this["maxResults"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageTraceQuery instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageTraceQuery {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new MessageTraceQuery($$parsedSource as Partial<MessageTraceQuery>);
+    }
+}
+
+/**
+ * MessageTraceResult 消息轨迹查询结果
+ */
+export class MessageTraceResult {
+    /**
+     * 轨迹 Topic
+     */
+    "traceTopic": string;
+
+    /**
+     * 各消息轨迹
+     */
+    "traces": MessageTrace[];
+
+    /** Creates a new MessageTraceResult instance. */
+    constructor($$source: Partial<MessageTraceResult> = {}) {
+        if (!("traceTopic" in $$source)) {
+            this["traceTopic"] = "";
+        }
+        if (!("traces" in $$source)) {
+            this["traces"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MessageTraceResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MessageTraceResult {
+        const $$createField1_0 = $$createType51;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("traces" in $$parsedSource) {
+            $$parsedSource["traces"] = $$createField1_0($$parsedSource["traces"]);
+        }
+        return new MessageTraceResult($$parsedSource as Partial<MessageTraceResult>);
+    }
+}
+
+/**
+ * NameServerNode NameServer 节点信息
+ */
+export class NameServerNode {
+    /**
+     * 节点ID
+     */
+    "id": number;
+
+    /**
+     * 所属集群
+     */
+    "cluster": string;
+
+    /**
+     * 节点地址
+     */
+    "address": string;
+
+    /**
+     * 版本号
+     */
+    "version": string;
+
+    /**
+     * 节点状态
+     */
+    "status": NodeStatus;
+
+    /**
+     * 最后可见时间
+     */
+    "lastSeen": string;
+
+    /** Creates a new NameServerNode instance. */
+    constructor($$source: Partial<NameServerNode> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = 0;
+        }
+        if (!("cluster" in $$source)) {
+            this["cluster"] = "";
+        }
+        if (!("address" in $$source)) {
+            this["address"] = "";
+        }
+        if (!("version" in $$source)) {
+            this["version"] = "";
+        }
+        if (!("status" in $$source)) {
+            this["status"] = NodeStatus.$zero;
+        }
+        if (!("lastSeen" in $$source)) {
+            this["lastSeen"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new NameServerNode instance from a string or object.
+     */
+    static createFrom($$source: any = {}): NameServerNode {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new NameServerNode($$parsedSource as Partial<NameServerNode>);
+    }
+}
+
+/**
+ * NodeStatus 节点状态
+ */
+export enum NodeStatus {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    NodeOnline = "online",
+    NodeWarning = "warning",
+    NodeOffline = "offline",
+};
+
+/**
+ * OffsetDiffItem 位点差异条目
+ */
+export class OffsetDiffItem {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 基准位点（-1 表示不存在）
+     */
+    "baseOffset": number;
+
+    /**
+     * 对比位点（-1 表示不存在）
+     */
+    "newOffset": number;
+
+    /**
+     * 位点差值
+     */
+    "delta": number;
+
+    /** Creates a new OffsetDiffItem instance. */
+    constructor($$source: Partial<OffsetDiffItem> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("baseOffset" in $$source)) {
+            this["baseOffset"] = 0;
+        }
+        if (!("newOffset" in $$source)) {
+            this["newOffset"] = 0;
+        }
+        if (!("delta" in $$source)) {
+            this["delta"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new OffsetDiffItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): OffsetDiffItem {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new OffsetDiffItem($$parsedSource as Partial<OffsetDiffItem>);
+    }
+}
+
+/**
+ * OffsetSnapshot 消费位点快照
+ */
+export class OffsetSnapshot {
+    /**
+     * 快照文件格式版本
+     */
+    "version": number;
+
+    /**
+     * 快照ID
+     */
+    "id": string;
+
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 采集时的 NameServer 地址
+     */
+    "nameServer": string;
+
+    /**
+     * 创建时间
+     */
+    "createdAt": string;
+
+    /**
+     * 创建时间戳(毫秒)
+     */
+    "timestamp": number;
+
+    /**
+     * 快照来源，旧版本快照文件缺省时视为手动创建
+     */
+    "source": OffsetSnapshotSource;
+
+    /**
+     * 备注
+     */
+    "remark": string;
+
+    /**
+     * 各队列位点
+     */
+    "offsets": QueueOffset[];
+
+    /** Creates a new OffsetSnapshot instance. */
+    constructor($$source: Partial<OffsetSnapshot> = {}) {
+        if (!("version" in $$source)) {
+            this["version"] = 0;
+        }
+        if (!("id" in $$source)) {
+            this["id"] = "";
+        }
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("nameServer" in $$source)) {
+            this["nameServer"] = "";
+        }
+        if (!("createdAt" in $$source)) {
+            this["createdAt"] = "";
+        }
+        if (!("timestamp" in $$source)) {
+            this["timestamp"] = 0;
+        }
+        if (!("source" in $$source)) {
+            this["source"] = OffsetSnapshotSource.$zero;
+        }
+        if (!("remark" in $$source)) {
+            this["remark"] = "";
+        }
+        if (!("offsets" in $$source)) {
+            this["offsets"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new OffsetSnapshot instance from a string or object.
+     */
+    static createFrom($$source: any = {}): OffsetSnapshot {
+        const $$createField8_0 = $$createType53;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("offsets" in $$parsedSource) {
+            $$parsedSource["offsets"] = $$createField8_0($$parsedSource["offsets"]);
+        }
+        return new OffsetSnapshot($$parsedSource as Partial<OffsetSnapshot>);
+    }
+}
+
+/**
+ * OffsetSnapshotInfo 消费位点快照摘要（用于列表展示）
+ */
+export class OffsetSnapshotInfo {
+    /**
+     * 快照ID
+     */
+    "id": string;
+
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 采集时的 NameServer 地址
+     */
+    "nameServer": string;
+
+    /**
+     * 创建时间
+     */
+    "createdAt": string;
+
+    /**
+     * 创建时间戳(毫秒)
+     */
+    "timestamp": number;
+
+    /**
+     * 快照来源
+     */
+    "source": OffsetSnapshotSource;
+
+    /**
+     * 备注
+     */
+    "remark": string;
+
+    /**
+     * 包含的 Topic 列表
+     */
+    "topics": string[];
+
+    /**
+     * 队列数量
+     */
+    "queueCount": number;
+
+    /** Creates a new OffsetSnapshotInfo instance. */
+    constructor($$source: Partial<OffsetSnapshotInfo> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = "";
+        }
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("nameServer" in $$source)) {
+            this["nameServer"] = "";
+        }
+        if (!("createdAt" in $$source)) {
+            this["createdAt"] = "";
+        }
+        if (!("timestamp" in $$source)) {
+            this["timestamp"] = 0;
+        }
+        if (!("source" in $$source)) {
+            this["source"] = OffsetSnapshotSource.$zero;
+        }
+        if (!("remark" in $$source)) {
+            this["remark"] = "";
+        }
+        if (!("topics" in $$source)) {
+            this["topics"] = [];
+        }
+        if (!("queueCount" in $$source)) {
+            this["queueCount"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new OffsetSnapshotInfo instance from a string or object.
+     */
+    static createFrom($$source: any = {}): OffsetSnapshotInfo {
+        const $$createField7_0 = $$createType0;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("topics" in $$parsedSource) {
+            $$parsedSource["topics"] = $$createField7_0($$parsedSource["topics"]);
+        }
+        return new OffsetSnapshotInfo($$parsedSource as Partial<OffsetSnapshotInfo>);
+    }
+}
+
+/**
+ * OffsetSnapshotSource 消费位点快照来源
+ */
+export enum OffsetSnapshotSource {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * 手动创建
+     */
+    SnapshotManual = "manual",
+
+    /**
+     * 跳过堆积前自动创建，可撤销
+     */
+    SnapshotSkipBacklog = "skipBacklog",
+};
+
+/**
+ * OffsetUpdateResult 单个队列的位点写入结果
+ */
+export class OffsetUpdateResult {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * Broker 地址
+     */
+    "brokerAddr": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 写入的位点
+     */
+    "offset": number;
+
+    /**
+     * 是否成功
+     */
+    "success": boolean;
+
+    /**
+     * 错误信息
+     */
+    "error": string;
+
+    /** Creates a new OffsetUpdateResult instance. */
+    constructor($$source: Partial<OffsetUpdateResult> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("brokerAddr" in $$source)) {
+            this["brokerAddr"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("offset" in $$source)) {
+            this["offset"] = 0;
+        }
+        if (!("success" in $$source)) {
+            this["success"] = false;
+        }
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new OffsetUpdateResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): OffsetUpdateResult {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new OffsetUpdateResult($$parsedSource as Partial<OffsetUpdateResult>);
+    }
+}
+
+/**
+ * PoisonMessageCandidate 疑似毒消息（反复消费失败的原始消息）
+ */
+export class PoisonMessageCandidate {
+    /**
+     * 原始消息ID
+     */
+    "originMsgId": string;
+
+    /**
+     * 原始 Topic
+     */
+    "originTopic": string;
+
+    /**
+     * 消息Keys
+     */
+    "keys": string;
+
+    /**
+     * 在重试 Topic 中出现的次数
+     */
+    "occurrences": number;
+
+    /**
+     * 最大重试次数
+     */
+    "maxReconsumeTimes": number;
+
+    /**
+     * 首次出现时间
+     */
+    "firstSeen": string;
+
+    /**
+     * 最近出现时间
+     */
+    "lastSeen": string;
+
+    /** Creates a new PoisonMessageCandidate instance. */
+    constructor($$source: Partial<PoisonMessageCandidate> = {}) {
+        if (!("originMsgId" in $$source)) {
+            this["originMsgId"] = "";
+        }
+        if (!("originTopic" in $$source)) {
+            this["originTopic"] = "";
+        }
+        if (!("keys" in $$source)) {
+            this["keys"] = "";
+        }
+        if (!("occurrences" in $$source)) {
+            this["occurrences"] = 0;
+        }
+        if (!("maxReconsumeTimes" in $$source)) {
+            this["maxReconsumeTimes"] = 0;
+        }
+        if (!("firstSeen" in $$source)) {
+            this["firstSeen"] = "";
+        }
+        if (!("lastSeen" in $$source)) {
+            this["lastSeen"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new PoisonMessageCandidate instance from a string or object.
+     */
+    static createFrom($$source: any = {}): PoisonMessageCandidate {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new PoisonMessageCandidate($$parsedSource as Partial<PoisonMessageCandidate>);
+    }
+}
+
+/**
+ * PopConsumeStats Pop 消费统计
+ */
+export class PopConsumeStats {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 当前消费类型
+     */
+    "consumeType": ConsumeType;
+
+    /**
+     * 不可见消息总数
+     */
+    "totalInvisible": number;
+
+    /**
+     * 未确认消息总数
+     */
+    "totalBacklog": number;
+
+    /**
+     * 各队列统计
+     */
+    "queues": PopQueueStats[];
+
+    /**
+     * Revive 队列进度
+     */
+    "revive": ReviveQueueStats[];
+
+    /**
+     * 获取 Revive 进度失败时的错误
+     */
+    "reviveError": string;
+
+    /**
+     * 查询时间
+     */
+    "queriedAt": string;
+
+    /** Creates a new PopConsumeStats instance. */
+    constructor($$source: Partial<PopConsumeStats> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("consumeType" in $$source)) {
+            this["consumeType"] = ConsumeType.$zero;
+        }
+        if (!("totalInvisible" in $$source)) {
+            this["totalInvisible"] = 0;
+        }
+        if (!("totalBacklog" in $$source)) {
+            this["totalBacklog"] = 0;
+        }
+        if (!("queues" in $$source)) {
+            this["queues"] = [];
+        }
+        if (!("revive" in $$source)) {
+            this["revive"] = [];
+        }
+        if (!("reviveError" in $$source)) {
+            this["reviveError"] = "";
+        }
+        if (!("queriedAt" in $$source)) {
+            this["queriedAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new PopConsumeStats instance from a string or object.
+     */
+    static createFrom($$source: any = {}): PopConsumeStats {
+        const $$createField4_0 = $$createType55;
+        const $$createField5_0 = $$createType57;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("queues" in $$parsedSource) {
+            $$parsedSource["queues"] = $$createField4_0($$parsedSource["queues"]);
+        }
+        if ("revive" in $$parsedSource) {
+            $$parsedSource["revive"] = $$createField5_0($$parsedSource["revive"]);
+        }
+        return new PopConsumeStats($$parsedSource as Partial<PopConsumeStats>);
+    }
+}
+
+/**
+ * PopQueueStats Pop 消费队列统计
+ */
+export class PopQueueStats {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * Broker 最大位点
+     */
+    "brokerOffset": number;
+
+    /**
+     * 已 Pop 位点
+     */
+    "popOffset": number;
+
+    /**
+     * 已确认位点（消费位点）
+     */
+    "ackOffset": number;
+
+    /**
+     * 已 Pop 未确认（不可见）消息数
+     */
+    "invisible": number;
+
+    /**
+     * 未确认消息数（含未 Pop）
+     */
+    "backlog": number;
+
+    /** Creates a new PopQueueStats instance. */
+    constructor($$source: Partial<PopQueueStats> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("brokerOffset" in $$source)) {
+            this["brokerOffset"] = 0;
+        }
+        if (!("popOffset" in $$source)) {
+            this["popOffset"] = 0;
+        }
+        if (!("ackOffset" in $$source)) {
+            this["ackOffset"] = 0;
+        }
+        if (!("invisible" in $$source)) {
+            this["invisible"] = 0;
+        }
+        if (!("backlog" in $$source)) {
+            this["backlog"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new PopQueueStats instance from a string or object.
+     */
+    static createFrom($$source: any = {}): PopQueueStats {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new PopQueueStats($$parsedSource as Partial<PopQueueStats>);
+    }
+}
+
+/**
+ * ProcessQueueItem 客户端本地处理队列信息
+ */
+export class ProcessQueueItem {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 已提交位点
+     */
+    "commitOffset": number;
+
+    /**
+     * 本地缓存消息数
+     */
+    "cachedMsgCount": number;
+
+    /**
+     * 本地缓存消息大小(MiB)
+     */
+    "cachedMsgSizeInMiB": number;
+
+    /**
+     * 缓存消息最小位点
+     */
+    "cachedMsgMinOffset": number;
+
+    /**
+     * 缓存消息最大位点
+     */
+    "cachedMsgMaxOffset": number;
+
+    /**
+     * 是否已锁定（顺序消费）
+     */
+    "locked": boolean;
+
+    /**
+     * 最后加锁时间
+     */
+    "lastLockTime": string;
+
+    /**
+     * 是否已丢弃
+     */
+    "dropped": boolean;
+
+    /**
+     * 最后拉取时间
+     */
+    "lastPullTime": string;
+
+    /**
+     * 最后消费时间
+     */
+    "lastConsumeTime": string;
+
+    /** Creates a new ProcessQueueItem instance. */
+    constructor($$source: Partial<ProcessQueueItem> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("commitOffset" in $$source)) {
+            this["commitOffset"] = 0;
+        }
+        if (!("cachedMsgCount" in $$source)) {
+            this["cachedMsgCount"] = 0;
+        }
+        if (!("cachedMsgSizeInMiB" in $$source)) {
+            this["cachedMsgSizeInMiB"] = 0;
+        }
+        if (!("cachedMsgMinOffset" in $$source)) {
+            this["cachedMsgMinOffset"] = 0;
+        }
+        if (!("cachedMsgMaxOffset" in $$source)) {
+            this["cachedMsgMaxOffset"] = 0;
+        }
+        if (!("locked" in $$source)) {
+            this["locked"] = false;
+        }
+        if (!("lastLockTime" in $$source)) {
+            this["lastLockTime"] = "";
+        }
+        if (!("dropped" in $$source)) {
+            this["dropped"] = false;
+        }
+        if (!("lastPullTime" in $$source)) {
+            this["lastPullTime"] = "";
+        }
+        if (!("lastConsumeTime" in $$source)) {
+            this["lastConsumeTime"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ProcessQueueItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ProcessQueueItem {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ProcessQueueItem($$parsedSource as Partial<ProcessQueueItem>);
+    }
+}
+
+/**
+ * ProducerClient 生产者客户端信息
+ */
+export class ProducerClient {
+    /**
+     * 客户端ID
+     */
+    "clientId": string;
+
+    /**
+     * 客户端地址
+     */
+    "ip": string;
+
+    /**
+     * 客户端语言
+     */
+    "language": string;
+
+    /**
+     * 版本名称，如 V4_9_4
+     */
+    "version": string;
+
+    /**
+     * 客户端上报的原始版本号
+     */
+    "versionCode": number;
+
+    /**
+     * 最近一次上报心跳的 Broker
+     */
+    "brokerName": string;
+
+    /**
+     * 最后心跳时间，取自 Broker 生产者心跳表
+     */
+    "lastUpdate": string;
+
+    /** Creates a new ProducerClient instance. */
+    constructor($$source: Partial<ProducerClient> = {}) {
+        if (!("clientId" in $$source)) {
+            this["clientId"] = "";
+        }
+        if (!("ip" in $$source)) {
+            this["ip"] = "";
+        }
+        if (!("language" in $$source)) {
+            this["language"] = "";
+        }
+        if (!("version" in $$source)) {
+            this["version"] = "";
+        }
+        if (!("versionCode" in $$source)) {
+            this["versionCode"] = 0;
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("lastUpdate" in $$source)) {
+            this["lastUpdate"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ProducerClient instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ProducerClient {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ProducerClient($$parsedSource as Partial<ProducerClient>);
+    }
+}
+
+/**
+ * ProducerGroupItem 生产者组信息
+ */
+export class ProducerGroupItem {
+    /**
+     * 序号
+     */
+    "id": number;
+
+    /**
+     * 生产者组名称
+     */
+    "group": string;
+
+    /**
+     * 在线客户端数
+     */
+    "onlineClients": number;
+
+    /**
+     * 存在该生产者组连接的 Broker
+     */
+    "brokers": string[];
+
+    /**
+     * 同一语言的客户端版本是否不一致
+     */
+    "mixedVersions": boolean;
+
+    /**
+     * 客户端列表
+     */
+    "clients": ProducerClient[];
+
+    /** Creates a new ProducerGroupItem instance. */
+    constructor($$source: Partial<ProducerGroupItem> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = 0;
+        }
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("onlineClients" in $$source)) {
+            this["onlineClients"] = 0;
+        }
+        if (!("brokers" in $$source)) {
+            this["brokers"] = [];
+        }
+        if (!("mixedVersions" in $$source)) {
+            this["mixedVersions"] = false;
+        }
+        if (!("clients" in $$source)) {
+            this["clients"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ProducerGroupItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ProducerGroupItem {
+        const $$createField3_0 = $$createType0;
+        const $$createField5_0 = $$createType59;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("brokers" in $$parsedSource) {
+            $$parsedSource["brokers"] = $$createField3_0($$parsedSource["brokers"]);
+        }
+        if ("clients" in $$parsedSource) {
+            $$parsedSource["clients"] = $$createField5_0($$parsedSource["clients"]);
+        }
+        return new ProducerGroupItem($$parsedSource as Partial<ProducerGroupItem>);
+    }
+}
+
+/**
+ * QueueAllocationResult 消费者组队列分配结果
+ */
+export class QueueAllocationResult {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 消息模式
+     */
+    "messageModel": string;
+
+    /**
+     * 各 Topic 分配情况
+     */
+    "topics": TopicAllocation[];
+
+    /**
+     * 发现的问题
+     */
+    "issues": AllocationIssue[];
+
+    /**
+     * 检查时间
+     */
+    "checkedAt": string;
+
+    /** Creates a new QueueAllocationResult instance. */
+    constructor($$source: Partial<QueueAllocationResult> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("messageModel" in $$source)) {
+            this["messageModel"] = "";
+        }
+        if (!("topics" in $$source)) {
+            this["topics"] = [];
+        }
+        if (!("issues" in $$source)) {
+            this["issues"] = [];
+        }
+        if (!("checkedAt" in $$source)) {
+            this["checkedAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new QueueAllocationResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): QueueAllocationResult {
+        const $$createField2_0 = $$createType61;
+        const $$createField3_0 = $$createType63;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("topics" in $$parsedSource) {
+            $$parsedSource["topics"] = $$createField2_0($$parsedSource["topics"]);
+        }
+        if ("issues" in $$parsedSource) {
+            $$parsedSource["issues"] = $$createField3_0($$parsedSource["issues"]);
+        }
+        return new QueueAllocationResult($$parsedSource as Partial<QueueAllocationResult>);
+    }
+}
+
+/**
+ * QueueAssignment 单个队列的分配情况
+ */
+export class QueueAssignment {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 持有该队列的客户端，为空表示未分配
+     */
+    "clientIds": string[];
+
+    /**
+     * Broker 最大位点
+     */
+    "brokerOffset": number;
+
+    /**
+     * 消费位点
+     */
+    "consumerOffset": number;
+
+    /**
+     * 堆积量
+     */
+    "lag": number;
+
+    /** Creates a new QueueAssignment instance. */
+    constructor($$source: Partial<QueueAssignment> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("clientIds" in $$source)) {
+            this["clientIds"] = [];
+        }
+        if (!("brokerOffset" in $$source)) {
+            this["brokerOffset"] = 0;
+        }
+        if (!("consumerOffset" in $$source)) {
+            this["consumerOffset"] = 0;
+        }
+        if (!("lag" in $$source)) {
+            this["lag"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new QueueAssignment instance from a string or object.
+     */
+    static createFrom($$source: any = {}): QueueAssignment {
+        const $$createField3_0 = $$createType0;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("clientIds" in $$parsedSource) {
+            $$parsedSource["clientIds"] = $$createField3_0($$parsedSource["clientIds"]);
+        }
+        return new QueueAssignment($$parsedSource as Partial<QueueAssignment>);
+    }
+}
+
+/**
+ * QueueBrowseParams 按队列位点浏览消息参数
+ */
+export class QueueBrowseParams {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 起始位点，<0 表示从队列末尾向前取一页
+     */
+    "offset": number;
+
+    /**
+     * 每页数量
+     */
+    "count": number;
+
+    /** Creates a new QueueBrowseParams instance. */
+    constructor($$source: Partial<QueueBrowseParams> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("offset" in $$source)) {
+            this["offset"] = 0;
+        }
+        if (!("count" in $$source)) {
+            this["count"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new QueueBrowseParams instance from a string or object.
+     */
+    static createFrom($$source: any = {}): QueueBrowseParams {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new QueueBrowseParams($$parsedSource as Partial<QueueBrowseParams>);
+    }
+}
+
+/**
+ * QueueBrowseResult 按队列位点浏览消息结果
+ */
+export class QueueBrowseResult {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 队列最小位点
+     */
+    "minOffset": number;
+
+    /**
+     * 队列最大位点
+     */
+    "maxOffset": number;
+
+    /**
+     * 本页起始位点
+     */
+    "startOffset": number;
+
+    /**
+     * 下一页起始位点
+     */
+    "nextOffset": number;
+
+    /**
+     * 上一页起始位点
+     */
+    "prevOffset": number;
+
+    /**
+     * 是否有下一页
+     */
+    "hasNext": boolean;
+
+    /**
+     * 是否有上一页
+     */
+    "hasPrev": boolean;
+
+    /**
+     * 消息列表
+     */
+    "messages": (MessageItem | null)[];
+
+    /** Creates a new QueueBrowseResult instance. */
+    constructor($$source: Partial<QueueBrowseResult> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("minOffset" in $$source)) {
+            this["minOffset"] = 0;
+        }
+        if (!("maxOffset" in $$source)) {
+            this["maxOffset"] = 0;
+        }
+        if (!("startOffset" in $$source)) {
+            this["startOffset"] = 0;
+        }
+        if (!("nextOffset" in $$source)) {
+            this["nextOffset"] = 0;
+        }
+        if (!("prevOffset" in $$source)) {
+            this["prevOffset"] = 0;
+        }
+        if (!("hasNext" in $$source)) {
+            this["hasNext"] = false;
+        }
+        if (!("hasPrev" in $$source)) {
+            this["hasPrev"] = false;
+        }
+        if (!("messages" in $$source)) {
+            this["messages"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new QueueBrowseResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): QueueBrowseResult {
+        const $$createField10_0 = $$createType3;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("messages" in $$parsedSource) {
+            $$parsedSource["messages"] = $$createField10_0($$parsedSource["messages"]);
+        }
+        return new QueueBrowseResult($$parsedSource as Partial<QueueBrowseResult>);
+    }
+}
+
+/**
+ * QueueLockItem 顺序消费队列锁信息
+ */
+export class QueueLockItem {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 锁状态
+     */
+    "state": QueueLockState;
+
+    /**
+     * 持有锁（或已分配）的客户端，未在线时为最近一次观察到的持有者，未知时为空
+     */
+    "holderClientId": string;
+
+    /**
+     * 客户端最后续锁时间
+     */
+    "lastLockTime": string;
+
+    /**
+     * Broker 端锁预计过期时间
+     */
+    "expireTime": string;
+
+    /**
+     * 是否已超过锁有效期仍未续锁
+     */
+    "expired": boolean;
+
+    /**
+     * 堆积量
+     */
+    "lag": number;
+
+    /** Creates a new QueueLockItem instance. */
+    constructor($$source: Partial<QueueLockItem> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("state" in $$source)) {
+            this["state"] = QueueLockState.$zero;
+        }
+        if (!("holderClientId" in $$source)) {
+            this["holderClientId"] = "";
+        }
+        if (!("lastLockTime" in $$source)) {
+            this["lastLockTime"] = "";
+        }
+        if (!("expireTime" in $$source)) {
+            this["expireTime"] = "";
+        }
+        if (!("expired" in $$source)) {
+            this["expired"] = false;
+        }
+        if (!("lag" in $$source)) {
+            this["lag"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new QueueLockItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): QueueLockItem {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new QueueLockItem($$parsedSource as Partial<QueueLockItem>);
+    }
+}
+
+/**
+ * QueueLockResult 消费者组队列锁检查结果
+ */
+export class QueueLockResult {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 消费者组是否配置为顺序消费
+     */
+    "orderly": boolean;
+
+    /**
+     * 是否探测了 Broker 端锁状态
+     */
+    "probed": boolean;
+
+    /**
+     * 各队列锁信息
+     */
+    "queues": QueueLockItem[];
+
+    /**
+     * 检查时间
+     */
+    "checkedAt": string;
+
+    /** Creates a new QueueLockResult instance. */
+    constructor($$source: Partial<QueueLockResult> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("orderly" in $$source)) {
+            this["orderly"] = false;
+        }
+        if (!("probed" in $$source)) {
+            this["probed"] = false;
+        }
+        if (!("queues" in $$source)) {
+            this["queues"] = [];
+        }
+        if (!("checkedAt" in $$source)) {
+            this["checkedAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new QueueLockResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): QueueLockResult {
+        const $$createField3_0 = $$createType65;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("queues" in $$parsedSource) {
+            $$parsedSource["queues"] = $$createField3_0($$parsedSource["queues"]);
+        }
+        return new QueueLockResult($$parsedSource as Partial<QueueLockResult>);
+    }
+}
+
+/**
+ * QueueLockState Broker 端队列锁状态
+ */
+export enum QueueLockState {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * 由在线客户端持有
+     */
+    LockHeld = "held",
+
+    /**
+     * 被非在线客户端持有（可能是崩溃的客户端）
+     */
+    LockOrphan = "orphan",
+
+    /**
+     * 未被锁定
+     */
+    LockFree = "free",
+
+    /**
+     * 已分配给在线客户端，尚未加锁（不探测）
+     */
+    LockPending = "pending",
+
+    /**
+     * 未探测
+     */
+    LockUnknown = "unknown",
+};
+
+/**
+ * QueueOffset 单个队列的消费位点
+ */
+export class QueueOffset {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * Broker 最大位点
+     */
+    "brokerOffset": number;
+
+    /**
+     * 消费位点
+     */
+    "consumerOffset": number;
+
+    /**
+     * 最后消费消息的存储时间戳(毫秒)
+     */
+    "lastTimestamp": number;
+
+    /** Creates a new QueueOffset instance. */
+    constructor($$source: Partial<QueueOffset> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("brokerOffset" in $$source)) {
+            this["brokerOffset"] = 0;
+        }
+        if (!("consumerOffset" in $$source)) {
+            this["consumerOffset"] = 0;
+        }
+        if (!("lastTimestamp" in $$source)) {
+            this["lastTimestamp"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new QueueOffset instance from a string or object.
+     */
+    static createFrom($$source: any = {}): QueueOffset {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new QueueOffset($$parsedSource as Partial<QueueOffset>);
+    }
+}
+
+/**
+ * QueueUnlockResult 单个队列解锁结果
+ */
+export class QueueUnlockResult {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 用于解锁的客户端ID
+     */
+    "clientId": string;
+
+    /**
+     * 是否成功
+     */
+    "success": boolean;
+
+    /**
+     * 结果说明
+     */
+    "message": string;
+
+    /**
+     * 错误信息
+     */
+    "error": string;
+
+    /** Creates a new QueueUnlockResult instance. */
+    constructor($$source: Partial<QueueUnlockResult> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("clientId" in $$source)) {
+            this["clientId"] = "";
+        }
+        if (!("success" in $$source)) {
+            this["success"] = false;
+        }
+        if (!("message" in $$source)) {
+            this["message"] = "";
+        }
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new QueueUnlockResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): QueueUnlockResult {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new QueueUnlockResult($$parsedSource as Partial<QueueUnlockResult>);
+    }
+}
+
+/**
+ * QueueUnlockTarget 需要强制解锁的队列
+ */
+export class QueueUnlockTarget {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 持有锁的客户端ID，为空时自动查找
+     */
+    "clientId": string;
+
+    /** Creates a new QueueUnlockTarget instance. */
+    constructor($$source: Partial<QueueUnlockTarget> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("clientId" in $$source)) {
+            this["clientId"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new QueueUnlockTarget instance from a string or object.
+     */
+    static createFrom($$source: any = {}): QueueUnlockTarget {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new QueueUnlockTarget($$parsedSource as Partial<QueueUnlockTarget>);
+    }
+}
+
+/**
+ * RedeliverTarget 死信消息重投目标
+ */
+export enum RedeliverTarget {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * 投递回原始 Topic
+     */
+    RedeliverToOrigin = "origin",
+
+    /**
+     * 投递到消费者组重试 Topic
+     */
+    RedeliverToRetry = "retry",
+};
+
+/**
+ * RedeliveryRecord 死信消息重投记录（用于幂等）
+ */
+export class RedeliveryRecord {
+    /**
+     * 死信消息ID
+     */
+    "messageId": string;
+
+    /**
+     * 投递目标 Topic
+     */
+    "targetTopic": string;
+
+    /**
+     * 重投目标
+     */
+    "target": RedeliverTarget;
+
+    /**
+     * 新消息ID
+     */
+    "newMessageId": string;
+
+    /**
+     * 投递时间
+     */
+    "deliveredAt": string;
+
+    /** Creates a new RedeliveryRecord instance. */
+    constructor($$source: Partial<RedeliveryRecord> = {}) {
+        if (!("messageId" in $$source)) {
+            this["messageId"] = "";
+        }
+        if (!("targetTopic" in $$source)) {
+            this["targetTopic"] = "";
+        }
+        if (!("target" in $$source)) {
+            this["target"] = RedeliverTarget.$zero;
+        }
+        if (!("newMessageId" in $$source)) {
+            this["newMessageId"] = "";
+        }
+        if (!("deliveredAt" in $$source)) {
+            this["deliveredAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RedeliveryRecord instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RedeliveryRecord {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new RedeliveryRecord($$parsedSource as Partial<RedeliveryRecord>);
+    }
+}
+
+/**
+ * RetryMessageItem 重试消息
+ */
+export class RetryMessageItem {
+    /**
+     * 消息信息
+     */
+    "message": MessageItem | null;
+
+    /**
+     * 再次消费失败时的延迟级别
+     */
+    "nextDelayLevel": number;
+
+    /**
+     * 再次消费失败时的延迟时间
+     */
+    "nextRetryDelay": string;
+
+    /**
+     * 再次消费失败是否进入死信队列
+     */
+    "willDeadLetter": boolean;
+
+    /** Creates a new RetryMessageItem instance. */
+    constructor($$source: Partial<RetryMessageItem> = {}) {
+        if (!("message" in $$source)) {
+            this["message"] = null;
+        }
+        if (!("nextDelayLevel" in $$source)) {
+            this["nextDelayLevel"] = 0;
+        }
+        if (!("nextRetryDelay" in $$source)) {
+            this["nextRetryDelay"] = "";
+        }
+        if (!("willDeadLetter" in $$source)) {
+            this["willDeadLetter"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RetryMessageItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RetryMessageItem {
+        const $$createField0_0 = $$createType2;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("message" in $$parsedSource) {
+            $$parsedSource["message"] = $$createField0_0($$parsedSource["message"]);
+        }
+        return new RetryMessageItem($$parsedSource as Partial<RetryMessageItem>);
+    }
+}
+
+/**
+ * RetryQueryParams 重试消息查询参数
+ */
+export class RetryQueryParams {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 开始时间戳(毫秒)，0 表示不限
+     */
+    "startTime": number;
+
+    /**
+     * 结束时间戳(毫秒)，0 表示不限
+     */
+    "endTime": number;
+
+    /**
+     * 按消息 Key、消息ID或原始消息ID过滤
+     */
+    "key": string;
+
+    /**
+     * 最大返回消息数（统计不受此限制）
+     */
+    "maxResults": number;
+
+    /**
+     * 统计时间桶宽度(分钟)，<=0 表示默认值
+     */
+    "bucketMinutes": number;
+
+    /** Creates a new RetryQueryParams instance. */
+    constructor($$source: Partial<RetryQueryParams> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("startTime" in $$source)) {
+            this["startTime"] = 0;
+        }
+        if (!("endTime" in $$source)) {
+            this["endTime"] = 0;
+        }
+        if (!("key" in $$source)) {
+            this["key"] = "";
+        }
+        if (!("maxResults" in $$source)) {
+            this["maxResults"] = 0;
+        }
+        if (!("bucketMinutes" in $$source)) {
+            this["bucketMinutes"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RetryQueryParams instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RetryQueryParams {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new RetryQueryParams($$parsedSource as Partial<RetryQueryParams>);
+    }
+}
+
+/**
+ * RetryQueryResult 重试消息查询结果
+ */
+export class RetryQueryResult {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 重试 Topic
+     */
+    "topic": string;
+
+    /**
+     * 消费者组最大重试次数
+     */
+    "maxRetryTimes": number;
+
+    /**
+     * 重试消息
+     */
+    "messages": RetryMessageItem[];
+
+    /**
+     * 符合条件的消息数
+     */
+    "matched": number;
+
+    /**
+     * 重试率时间分布
+     */
+    "buckets": RetryRateBucket[];
+
+    /**
+     * 疑似毒消息
+     */
+    "poisonCandidates": PoisonMessageCandidate[];
+
+    /**
+     * 已扫描消息数
+     */
+    "scanned": number;
+
+    /**
+     * 是否因达到扫描上限被截断
+     */
+    "truncated": boolean;
+
+    /** Creates a new RetryQueryResult instance. */
+    constructor($$source: Partial<RetryQueryResult> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("maxRetryTimes" in $$source)) {
+            this["maxRetryTimes"] = 0;
+        }
+        if (!("messages" in $$source)) {
+            this["messages"] = [];
+        }
+        if (!("matched" in $$source)) {
+            this["matched"] = 0;
+        }
+        if (!("buckets" in $$source)) {
+            this["buckets"] = [];
+        }
+        if (!("poisonCandidates" in $$source)) {
+            this["poisonCandidates"] = [];
+        }
+        if (!("scanned" in $$source)) {
+            this["scanned"] = 0;
+        }
+        if (!("truncated" in $$source)) {
+            this["truncated"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RetryQueryResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RetryQueryResult {
+        const $$createField3_0 = $$createType67;
+        const $$createField5_0 = $$createType69;
+        const $$createField6_0 = $$createType71;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("messages" in $$parsedSource) {
+            $$parsedSource["messages"] = $$createField3_0($$parsedSource["messages"]);
+        }
+        if ("buckets" in $$parsedSource) {
+            $$parsedSource["buckets"] = $$createField5_0($$parsedSource["buckets"]);
+        }
+        if ("poisonCandidates" in $$parsedSource) {
+            $$parsedSource["poisonCandidates"] = $$createField6_0($$parsedSource["poisonCandidates"]);
+        }
+        return new RetryQueryResult($$parsedSource as Partial<RetryQueryResult>);
+    }
+}
+
+/**
+ * RetryRateBucket 重试消息时间桶统计
+ */
+export class RetryRateBucket {
+    /**
+     * 桶开始时间戳(毫秒)
+     */
+    "startTime": number;
+
+    /**
+     * 重试消息数
+     */
+    "count": number;
+
+    /**
+     * 涉及的原始消息数
+     */
+    "distinct": number;
+
+    /**
+     * 平均每分钟重试消息数
+     */
+    "perMinute": number;
+
+    /**
+     * 桶内最大重试次数
+     */
+    "maxRetries": number;
+
+    /** Creates a new RetryRateBucket instance. */
+    constructor($$source: Partial<RetryRateBucket> = {}) {
+        if (!("startTime" in $$source)) {
+            this["startTime"] = 0;
+        }
+        if (!("count" in $$source)) {
+            this["count"] = 0;
+        }
+        if (!("distinct" in $$source)) {
+            this["distinct"] = 0;
+        }
+        if (!("perMinute" in $$source)) {
+            this["perMinute"] = 0;
+        }
+        if (!("maxRetries" in $$source)) {
+            this["maxRetries"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RetryRateBucket instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RetryRateBucket {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new RetryRateBucket($$parsedSource as Partial<RetryRateBucket>);
+    }
+}
+
+/**
+ * ReviveQueueStats Broker 重投（Revive）队列进度
+ */
+export class ReviveQueueStats {
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * Revive 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 已处理的 Revive 位点
+     */
+    "reviveOffset": number;
+
+    /**
+     * Revive 队列最大位点
+     */
+    "maxOffset": number;
+
+    /**
+     * 待处理的 Revive 记录数
+     */
+    "pending": number;
+
+    /** Creates a new ReviveQueueStats instance. */
+    constructor($$source: Partial<ReviveQueueStats> = {}) {
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("reviveOffset" in $$source)) {
+            this["reviveOffset"] = 0;
+        }
+        if (!("maxOffset" in $$source)) {
+            this["maxOffset"] = 0;
+        }
+        if (!("pending" in $$source)) {
+            this["pending"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ReviveQueueStats instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ReviveQueueStats {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ReviveQueueStats($$parsedSource as Partial<ReviveQueueStats>);
+    }
+}
+
+/**
+ * SendMessageRequest 发送消息请求
+ */
+export class SendMessageRequest {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 消息标签
+     */
+    "tags": string;
+
+    /**
+     * 消息Keys
+     */
+    "keys": string[];
+
+    /**
+     * 消息体
+     */
+    "body": string;
+
+    /**
+     * 自定义属性
+     */
+    "properties": { [_ in string]?: string };
+
+    /**
+     * 延迟级别(1-18)，0 表示不延迟
+     */
+    "delayLevel": number;
+
+    /**
+     * 定时投递时间戳(毫秒)，0 表示不定时（需 5.x Broker）
+     */
+    "deliverTime": number;
+
+    /**
+     * 分区顺序键，相同键的消息发送到同一队列
+     */
+    "shardingKey": string;
+
+    /**
+     * 指定发送的 Broker，为空时自动选择队列
+     */
+    "brokerName": string;
+
+    /**
+     * 指定发送的队列ID（BrokerName 不为空时生效）
+     */
+    "queueId": number;
+
+    /** Creates a new SendMessageRequest instance. */
+    constructor($$source: Partial<SendMessageRequest> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("tags" in $$source)) {
+            this["tags"] = "";
+        }
+        if (!("keys" in $$source)) {
+            this["keys"] = [];
+        }
+        if (!("body" in $$source)) {
+            this["body"] = "";
+        }
+        if (!("properties" in $$source)) {
+            this["properties"] = {};
+        }
+        if (!("delayLevel" in $$source)) {
+            this["delayLevel"] = 0;
+        }
+        if (!("deliverTime" in $$source)) {
+            this["deliverTime"] = 0;
+        }
+        if (!("shardingKey" in $$source)) {
+            this["shardingKey"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new SendMessageRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): SendMessageRequest {
+        const $$createField2_0 = $$createType0;
+        const $$createField4_0 = $$createType10;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("keys" in $$parsedSource) {
+            $$parsedSource["keys"] = $$createField2_0($$parsedSource["keys"]);
+        }
+        if ("properties" in $$parsedSource) {
+            $$parsedSource["properties"] = $$createField4_0($$parsedSource["properties"]);
+        }
+        return new SendMessageRequest($$parsedSource as Partial<SendMessageRequest>);
+    }
+}
+
+/**
+ * SendMessageResult 发送消息结果
+ */
+export class SendMessageResult {
+    /**
+     * 发送状态
+     */
+    "status": string;
+
+    /**
+     * 消息ID（UNIQ_KEY）
+     */
+    "messageId": string;
+
+    /**
+     * 偏移消息ID
+     */
+    "offsetMsgId": string;
+
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 写入的 Broker
+     */
+    "brokerName": string;
+
+    /**
+     * 写入的队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 队列偏移
+     */
+    "queueOffset": number;
+
+    /** Creates a new SendMessageResult instance. */
+    constructor($$source: Partial<SendMessageResult> = {}) {
+        if (!("status" in $$source)) {
+            this["status"] = "";
+        }
+        if (!("messageId" in $$source)) {
+            this["messageId"] = "";
+        }
+        if (!("offsetMsgId" in $$source)) {
+            this["offsetMsgId"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("queueOffset" in $$source)) {
+            this["queueOffset"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new SendMessageResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): SendMessageResult {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new SendMessageResult($$parsedSource as Partial<SendMessageResult>);
+    }
+}
+
+/**
+ * SkipBacklogItem 单个队列跳过堆积的预览
+ */
+export class SkipBacklogItem {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 当前消费位点
+     */
+    "consumerOffset": number;
+
+    /**
+     * 跳过后的位点（队列最大位点）
+     */
+    "maxOffset": number;
+
+    /**
+     * 将被跳过的消息数
+     */
+    "skipped": number;
+
+    /** Creates a new SkipBacklogItem instance. */
+    constructor($$source: Partial<SkipBacklogItem> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("consumerOffset" in $$source)) {
+            this["consumerOffset"] = 0;
+        }
+        if (!("maxOffset" in $$source)) {
+            this["maxOffset"] = 0;
+        }
+        if (!("skipped" in $$source)) {
+            this["skipped"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new SkipBacklogItem instance from a string or object.
+     */
+    static createFrom($$source: any = {}): SkipBacklogItem {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new SkipBacklogItem($$parsedSource as Partial<SkipBacklogItem>);
+    }
+}
+
+/**
+ * SkipBacklogPlan 跳过堆积预览
+ */
+export class SkipBacklogPlan {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 涉及的 Topic
+     */
+    "topics": string[];
+
+    /**
+     * 在线客户端数
+     */
+    "onlineClients": number;
+
+    /**
+     * 将被跳过的消息总数
+     */
+    "totalSkipped": number;
+
+    /**
+     * 各队列预览
+     */
+    "queues": SkipBacklogItem[];
+
+    /** Creates a new SkipBacklogPlan instance. */
+    constructor($$source: Partial<SkipBacklogPlan> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("topics" in $$source)) {
+            this["topics"] = [];
+        }
+        if (!("onlineClients" in $$source)) {
+            this["onlineClients"] = 0;
+        }
+        if (!("totalSkipped" in $$source)) {
+            this["totalSkipped"] = 0;
+        }
+        if (!("queues" in $$source)) {
+            this["queues"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new SkipBacklogPlan instance from a string or object.
+     */
+    static createFrom($$source: any = {}): SkipBacklogPlan {
+        const $$createField1_0 = $$createType0;
+        const $$createField4_0 = $$createType73;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("topics" in $$parsedSource) {
+            $$parsedSource["topics"] = $$createField1_0($$parsedSource["topics"]);
+        }
+        if ("queues" in $$parsedSource) {
+            $$parsedSource["queues"] = $$createField4_0($$parsedSource["queues"]);
+        }
+        return new SkipBacklogPlan($$parsedSource as Partial<SkipBacklogPlan>);
+    }
+}
+
+/**
+ * SkipBacklogRequest 跳过堆积请求
+ */
+export class SkipBacklogRequest {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * Topic 名称，为空表示全部订阅的 Topic
+     */
+    "topic": string;
+
+    /**
+     * 有在线客户端时是否强制执行
+     */
+    "force": boolean;
+
+    /**
+     * 保护模式下需输入消费者组名称确认
+     */
+    "confirm": string;
+
+    /** Creates a new SkipBacklogRequest instance. */
+    constructor($$source: Partial<SkipBacklogRequest> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("force" in $$source)) {
+            this["force"] = false;
+        }
+        if (!("confirm" in $$source)) {
+            this["confirm"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new SkipBacklogRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): SkipBacklogRequest {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new SkipBacklogRequest($$parsedSource as Partial<SkipBacklogRequest>);
+    }
+}
+
+/**
+ * SkipBacklogResult 跳过堆积结果
+ */
+export class SkipBacklogResult {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 跳过前自动创建的位点快照，没有在线客户端时可用于撤销
+     */
+    "snapshotId": string;
+
+    /**
+     * 跳过的消息总数
+     */
+    "totalSkipped": number;
+
+    /**
+     * 各队列位点写入结果
+     */
+    "offsets": OffsetUpdateResult[];
+
+    /** Creates a new SkipBacklogResult instance. */
+    constructor($$source: Partial<SkipBacklogResult> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("snapshotId" in $$source)) {
+            this["snapshotId"] = "";
+        }
+        if (!("totalSkipped" in $$source)) {
+            this["totalSkipped"] = 0;
+        }
+        if (!("offsets" in $$source)) {
+            this["offsets"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new SkipBacklogResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): SkipBacklogResult {
+        const $$createField3_0 = $$createType35;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("offsets" in $$parsedSource) {
+            $$parsedSource["offsets"] = $$createField3_0($$parsedSource["offsets"]);
+        }
+        return new SkipBacklogResult($$parsedSource as Partial<SkipBacklogResult>);
+    }
+}
+
+/**
+ * StuckConsumerEvent 检测到新的卡住队列时推送给前端的事件
+ */
+export class StuckConsumerEvent {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 新发现的卡住队列
+     */
+    "queues": StuckQueue[];
+
+    /**
+     * 检测时间
+     */
+    "detectedAt": string;
+
+    /** Creates a new StuckConsumerEvent instance. */
+    constructor($$source: Partial<StuckConsumerEvent> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("queues" in $$source)) {
+            this["queues"] = [];
+        }
+        if (!("detectedAt" in $$source)) {
+            this["detectedAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new StuckConsumerEvent instance from a string or object.
+     */
+    static createFrom($$source: any = {}): StuckConsumerEvent {
+        const $$createField1_0 = $$createType29;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("queues" in $$parsedSource) {
+            $$parsedSource["queues"] = $$createField1_0($$parsedSource["queues"]);
+        }
+        return new StuckConsumerEvent($$parsedSource as Partial<StuckConsumerEvent>);
+    }
+}
+
+/**
+ * StuckDetectionRequest 卡住消费检测参数
+ */
+export class StuckDetectionRequest {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 位点持续未推进多久视为卡住(秒)
+     */
+    "windowSeconds": number;
+
+    /**
+     * 采样间隔(秒)
+     */
+    "intervalSeconds": number;
+
+    /** Creates a new StuckDetectionRequest instance. */
+    constructor($$source: Partial<StuckDetectionRequest> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("windowSeconds" in $$source)) {
+            this["windowSeconds"] = 0;
+        }
+        if (!("intervalSeconds" in $$source)) {
+            this["intervalSeconds"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new StuckDetectionRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): StuckDetectionRequest {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new StuckDetectionRequest($$parsedSource as Partial<StuckDetectionRequest>);
+    }
+}
+
+/**
+ * StuckDetectionStatus 卡住消费检测状态
+ */
+export class StuckDetectionStatus {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 检测是否运行中
+     */
+    "running": boolean;
+
+    /**
+     * 判定窗口(秒)
+     */
+    "windowSeconds": number;
+
+    /**
+     * 采样间隔(秒)
+     */
+    "intervalSeconds": number;
+
+    /**
+     * 已采样次数
+     */
+    "samples": number;
+
+    /**
+     * 最近采样时间
+     */
+    "lastSampleAt": string;
+
+    /**
+     * 最近一次采样错误
+     */
+    "lastError": string;
+
+    /**
+     * 当前卡住的队列
+     */
+    "stuckQueues": StuckQueue[];
+
+    /** Creates a new StuckDetectionStatus instance. */
+    constructor($$source: Partial<StuckDetectionStatus> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("running" in $$source)) {
+            this["running"] = false;
+        }
+        if (!("windowSeconds" in $$source)) {
+            this["windowSeconds"] = 0;
+        }
+        if (!("intervalSeconds" in $$source)) {
+            this["intervalSeconds"] = 0;
+        }
+        if (!("samples" in $$source)) {
+            this["samples"] = 0;
+        }
+        if (!("lastSampleAt" in $$source)) {
+            this["lastSampleAt"] = "";
+        }
+        if (!("lastError" in $$source)) {
+            this["lastError"] = "";
+        }
+        if (!("stuckQueues" in $$source)) {
+            this["stuckQueues"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new StuckDetectionStatus instance from a string or object.
+     */
+    static createFrom($$source: any = {}): StuckDetectionStatus {
+        const $$createField7_0 = $$createType29;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("stuckQueues" in $$parsedSource) {
+            $$parsedSource["stuckQueues"] = $$createField7_0($$parsedSource["stuckQueues"]);
+        }
+        return new StuckDetectionStatus($$parsedSource as Partial<StuckDetectionStatus>);
+    }
+}
+
+/**
+ * StuckQueue 消费位点长时间未推进的队列
+ */
+export class StuckQueue {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * Broker 名称
+     */
+    "brokerName": string;
+
+    /**
+     * 队列ID
+     */
+    "queueId": number;
+
+    /**
+     * 持有该队列的客户端，未知时为空
+     */
+    "clientId": string;
+
+    /**
+     * 消费位点
+     */
+    "consumerOffset": number;
+
+    /**
+     * Broker 最大位点
+     */
+    "brokerOffset": number;
+
+    /**
+     * 堆积量
+     */
+    "lag": number;
+
+    /**
+     * 位点最后一次变化的时间
+     */
+    "stuckSince": string;
+
+    /**
+     * 未推进时长(秒)
+     */
+    "stuckSeconds": number;
+
+    /** Creates a new StuckQueue instance. */
+    constructor($$source: Partial<StuckQueue> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
+        }
+        if (!("queueId" in $$source)) {
+            this["queueId"] = 0;
+        }
+        if (!("clientId" in $$source)) {
+            this["clientId"] = "";
+        }
+        if (!("consumerOffset" in $$source)) {
+            this["consumerOffset"] = 0;
+        }
+        if (!("brokerOffset" in $$source)) {
+            this["brokerOffset"] = 0;
+        }
+        if (!("lag" in $$source)) {
+            this["lag"] = 0;
+        }
+        if (!("stuckSince" in $$source)) {
+            this["stuckSince"] = "";
+        }
+        if (!("stuckSeconds" in $$source)) {
+            this["stuckSeconds"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new StuckQueue instance from a string or object.
      */
-    "name": string;
+    static createFrom($$source: any = {}): StuckQueue {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new StuckQueue($$parsedSource as Partial<StuckQueue>);
+    }
+}
 
+/**
+ * SubscriptionCheckResult 订阅关系一致性检查结果
+ */
+export class SubscriptionCheckResult {
     /**
-     * 环境类型
+     * 消费者组名称
      */
-    "env": ConnectionEnv;
+    "group": string;
 
     /**
-     * NameServer 地址
+     * 是否一致
      */
-    "nameServer": string;
+    "consistent": boolean;
 
     /**
-     * 超时时间(秒)
+     * 各客户端订阅关系
      */
-    "timeoutSec": number;
+    "clients": ClientSubscription[];
 
     /**
-     * 是否启用 ACL 认证
+     * 发现的问题
      */
-    "enableACL": boolean;
+    "issues": SubscriptionIssue[];
 
     /**
-     * ACL AccessKey
+     * 检查时间
      */
-    "accessKey": string;
+    "checkedAt": string;
+
+    /** Creates a new SubscriptionCheckResult instance. */
+    constructor($$source: Partial<SubscriptionCheckResult> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("consistent" in $$source)) {
+            this["consistent"] = false;
+        }
+        if (!("clients" in $$source)) {
+            this["clients"] = [];
+        }
+        if (!("issues" in $$source)) {
+            this["issues"] = [];
+        }
+        if (!("checkedAt" in $$source)) {
+            this["checkedAt"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
 
     /**
-     * ACL SecretKey
+     * Creates a new SubscriptionCheckResult instance from a string or object.
      */
-    "secretKey": string;
+    static createFrom($$source: any = {}): SubscriptionCheckResult {
+        const $$createField2_0 = $$createType75;
+        const $$createField3_0 = $$createType77;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("clients" in $$parsedSource) {
+            $$parsedSource["clients"] = $$createField2_0($$parsedSource["clients"]);
+        }
+        if ("issues" in $$parsedSource) {
+            $$parsedSource["issues"] = $$createField3_0($$parsedSource["issues"]);
+        }
+        return new SubscriptionCheckResult($$parsedSource as Partial<SubscriptionCheckResult>);
+    }
+}
 
+/**
+ * SubscriptionIssue 订阅关系问题
+ */
+export class SubscriptionIssue {
     /**
-     * 连接状态
+     * 问题类型
      */
-    "status": ConnectionStatus;
+    "type": SubscriptionIssueType;
 
     /**
-     * 最近检测时间
+     * 相关 Topic
      */
-    "lastCheck": string;
+    "topic": string;
 
     /**
-     * 是否默认连接
+     * 问题描述
      */
-    "isDefault": boolean;
+    "message": string;
 
     /**
-     * 备注
+     * 相关客户端
      */
-    "remark": string;
+    "clientIds": string[];
 
-    /** Creates a new Connection instance. */
-    constructor($$source: Partial<Connection> = {}) {
-        if (!("id" in $$source)) {
-            this["id"] = 0;
-        }
-        if (!("name" in $$source)) {
-            this["name"] = "";
-        }
-        if (!("env" in $$source)) {
-            this["env"] = ConnectionEnv.$zero;
-        }
-        if (!("nameServer" in $$source)) {
-            this["nameServer"] = "";
-        }
-        if (!("timeoutSec" in $$source)) {
-            this["timeoutSec"] = 0;
-        }
-        if (!("enableACL" in $$source)) {
-            this["enableACL"] = false;
-        }
-        if (!("accessKey" in $$source)) {
-            this["accessKey"] = "";
+    /** Creates a new SubscriptionIssue instance. */
+    constructor($$source: Partial<SubscriptionIssue> = {}) {
+        if (!("type" in $$source)) {
+            this["type"] = SubscriptionIssueType.$zero;
         }
-        if (!("secretKey" in $$source)) {
-            this["secretKey"] = "";
-        }
-        if (!("status" in $$source)) {
-            this["status"] = ConnectionStatus.$zero;
-        }
-        if (!("lastCheck" in $$source)) {
-            this["lastCheck"] = "";
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
         }
-        if (!("isDefault" in $$source)) {
-            this["isDefault"] = false;
+        if (!("message" in $$source)) {
+            this["message"] = "";
         }
-        if (!("remark" in $$source)) {
-            this["remark"] = "";
+        if (!("clientIds" in $$source)) {
+            this["clientIds"] = [];
         }
 
         Object.assign(this, $$source);
     }
 
     /**
-     * Creates a new Connection instance from a string or object.
+     * Creates a new SubscriptionIssue instance from a string or object.
      */
-    static createFrom($$source: any = {}): Connection {
+    static createFrom($$source: any = {}): SubscriptionIssue {
+        const $$createField3_0 = $$createType0;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
-        return new Connection($$parsedSource as Partial<Connection>);
+        if ("clientIds" in $$parsedSource) {
+            $$parsedSource["clientIds"] = $$createField3_0($$parsedSource["clientIds"]);
+        }
+        return new SubscriptionIssue($$parsedSource as Partial<SubscriptionIssue>);
     }
 }
 
 /**
- * ConnectionEnv 连接环境类型
+ * SubscriptionIssueType 订阅关系问题类型
  */
-export enum ConnectionEnv {
+export enum SubscriptionIssueType {
     /**
      * The Go zero value for the underlying type of the enum.
      */
     $zero = "",
 
-    EnvProduction = "生产",
-    EnvTest = "测试",
-    EnvDevelopment = "开发",
-};
-
-/**
- * ConnectionStatus 连接状态
- */
-export enum ConnectionStatus {
     /**
-     * The Go zero value for the underlying type of the enum.
+     * 部分客户端未订阅该 Topic
      */
-    $zero = "",
-
-    StatusOnline = "online",
-    StatusOffline = "offline",
-};
+    IssueTopicMismatch = "topicMismatch",
 
-/**
- * ConsumeMode 消费模式
- */
-export enum ConsumeMode {
     /**
-     * The Go zero value for the underlying type of the enum.
+     * 过滤表达式不一致
      */
-    $zero = "",
-
-    ModeClustering = "CLUSTERING",
-    ModeBroadcasting = "BROADCASTING",
-};
+    IssueExpressionMismatch = "expressionMismatch",
 
-/**
- * ConsumerGroupItem 消费者组信息
- */
-export class ConsumerGroupItem {
     /**
-     * 消费者组ID
+     * 表达式类型不一致
      */
-    "id": number;
+    IssueExpressionTypeMismatch = "expressionTypeMismatch",
 
     /**
-     * 消费者组名称
+     * 订阅的 Topic 不存在
      */
-    "group": string;
+    IssueTopicNotFound = "topicNotFound",
 
     /**
-     * 所属集群
+     * 无法获取客户端订阅信息
      */
-    "cluster": string;
+    IssueClientUnreachable = "clientUnreachable",
+};
 
+/**
+ * SwitchConsumeTypeRequest 切换 Pop/Push 消费请求
+ */
+export class SwitchConsumeTypeRequest {
     /**
-     * 消费模式
+     * 消费者组名称
      */
-    "consumeMode": ConsumeMode;
+    "group": string;
 
     /**
-     * 状态
+     * Topic 名称，为空表示全部订阅的 Topic
      */
-    "status": GroupStatus;
+    "topic": string;
 
     /**
-     * 在线客户端数
+     * 目标消费类型（PUSH 或 POP）
      */
-    "onlineClients": number;
+    "consumeType": ConsumeType;
 
     /**
-     * 订阅 Topic 数
+     * Pop 模式下每个客户端共享的队列数，<=0 表示默认值
      */
-    "topicCount": number;
+    "popShareQueueNum": number;
 
     /**
-     * 堆积量
+     * 保护模式下需输入消费者组名称确认
      */
-    "lag": number;
+    "confirm": string;
 
-    /**
-     * 重试 QPS
-     */
-    "retryQps": number;
+    /** Creates a new SwitchConsumeTypeRequest instance. */
+    constructor($$source: Partial<SwitchConsumeTypeRequest> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("consumeType" in $$source)) {
+            this["consumeType"] = ConsumeType.$zero;
+        }
+        if (!("popShareQueueNum" in $$source)) {
+            this["popShareQueueNum"] = 0;
+        }
+        if (!("confirm" in $$source)) {
+            this["confirm"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
 
     /**
-     * 死信数量
+     * Creates a new SwitchConsumeTypeRequest instance from a string or object.
      */
-    "dlq": number;
+    static createFrom($$source: any = {}): SwitchConsumeTypeRequest {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new SwitchConsumeTypeRequest($$parsedSource as Partial<SwitchConsumeTypeRequest>);
+    }
+}
 
+/**
+ * SwitchConsumeTypeResult 单个 Broker、Topic 上的切换结果
+ */
+export class SwitchConsumeTypeResult {
     /**
-     * 最大重试次数
+     * Topic 名称
      */
-    "maxRetry": number;
+    "topic": string;
 
     /**
-     * 最后更新时间
+     * Broker 名称
      */
-    "lastUpdate": string;
+    "brokerName": string;
 
     /**
-     * 备注
+     * Broker 地址
      */
-    "remark": string;
+    "brokerAddr": string;
 
     /**
-     * 订阅关系列表
+     * 是否成功
      */
-    "subscriptions": GroupSubscription[];
+    "success": boolean;
 
     /**
-     * 客户端列表
+     * 错误信息（低于 5.x 的 Broker 不支持）
      */
-    "clients": GroupClient[];
+    "error": string;
 
-    /** Creates a new ConsumerGroupItem instance. */
-    constructor($$source: Partial<ConsumerGroupItem> = {}) {
-        if (!("id" in $$source)) {
-            this["id"] = 0;
-        }
-        if (!("group" in $$source)) {
-            this["group"] = "";
-        }
-        if (!("cluster" in $$source)) {
-            this["cluster"] = "";
-        }
-        if (!("consumeMode" in $$source)) {
-            this["consumeMode"] = ConsumeMode.$zero;
-        }
-        if (!("status" in $$source)) {
-            this["status"] = GroupStatus.$zero;
-        }
-        if (!("onlineClients" in $$source)) {
-            this["onlineClients"] = 0;
-        }
-        if (!("topicCount" in $$source)) {
-            this["topicCount"] = 0;
-        }
-        if (!("lag" in $$source)) {
-            this["lag"] = 0;
-        }
-        if (!("retryQps" in $$source)) {
-            this["retryQps"] = 0;
-        }
-        if (!("dlq" in $$source)) {
-            this["dlq"] = 0;
-        }
-        if (!("maxRetry" in $$source)) {
-            this["maxRetry"] = 0;
+    /** Creates a new SwitchConsumeTypeResult instance. */
+    constructor($$source: Partial<SwitchConsumeTypeResult> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
         }
-        if (!("lastUpdate" in $$source)) {
-            this["lastUpdate"] = "";
+        if (!("brokerName" in $$source)) {
+            this["brokerName"] = "";
         }
-        if (!("remark" in $$source)) {
-            this["remark"] = "";
+        if (!("brokerAddr" in $$source)) {
+            this["brokerAddr"] = "";
         }
-        if (!("subscriptions" in $$source)) {
-            this["subscriptions"] = [];
+        if (!("success" in $$source)) {
+            this["success"] = false;
         }
-        if (!("clients" in $$source)) {
-            this["clients"] = [];
+        if (!("error" in $$source)) {
+            this["error"] = "";
         }
 
         Object.assign(this, $$source);
     }
 
     /**
-     * Creates a new ConsumerGroupItem instance from a string or object.
+     * Creates a new SwitchConsumeTypeResult instance from a string or object.
      */
-    static createFrom($$source: any = {}): ConsumerGroupItem {
-        const $$createField13_0 = $$createType6;
-        const $$createField14_0 = $$createType8;
+    static createFrom($$source: any = {}): SwitchConsumeTypeResult {
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
-        if ("subscriptions" in $$parsedSource) {
-            $$parsedSource["subscriptions"] = $$createField13_0($$parsedSource["subscriptions"]);
-        }
-        if ("clients" in $$parsedSource) {
-            $$parsedSource["clients"] = $$createField14_0($$parsedSource["clients"]);
-        }
-        return new ConsumerGroupItem($$parsedSource as Partial<ConsumerGroupItem>);
+        return new SwitchConsumeTypeResult($$parsedSource as Partial<SwitchConsumeTypeResult>);
     }
 }
 
 /**
- * GroupClient 消费者客户端信息
+ * TailMessagesEvent 实时跟踪推送的一批新消息
  */
-export class GroupClient {
+export class TailMessagesEvent {
     /**
-     * 客户端ID
+     * 跟踪ID
      */
-    "clientId": string;
+    "tailId": string;
 
     /**
-     * IP 地址
+     * Topic 名称
      */
-    "ip": string;
+    "topic": string;
 
     /**
-     * 版本号
+     * 新消息，按存储时间排序
      */
-    "version": string;
+    "messages": (MessageItem | null)[];
 
     /**
-     * 最后心跳时间
+     * 本轮因超出速率上限被跳过的消息数
      */
-    "lastHeartbeat": string;
+    "dropped": number;
 
-    /** Creates a new GroupClient instance. */
-    constructor($$source: Partial<GroupClient> = {}) {
-        if (!("clientId" in $$source)) {
-            this["clientId"] = "";
+    /**
+     * 推送时间
+     */
+    "emittedAt": string;
+
+    /** Creates a new TailMessagesEvent instance. */
+    constructor($$source: Partial<TailMessagesEvent> = {}) {
+        if (!("tailId" in $$source)) {
+            this["tailId"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
         }
-        if (!("ip" in $$source)) {
-            this["ip"] = "";
+        if (!("messages" in $$source)) {
+            this["messages"] = [];
         }
-        if (!("version" in $$source)) {
-            this["version"] = "";
+        if (!("dropped" in $$source)) {
+            this["dropped"] = 0;
         }
-        if (!("lastHeartbeat" in $$source)) {
-            this["lastHeartbeat"] = "";
+        if (!("emittedAt" in $$source)) {
+            this["emittedAt"] = "";
         }
 
         Object.assign(this, $$source);
     }
 
     /**
-     * Creates a new GroupClient instance from a string or object.
+     * Creates a new TailMessagesEvent instance from a string or object.
      */
-    static createFrom($$source: any = {}): GroupClient {
+    static createFrom($$source: any = {}): TailMessagesEvent {
+        const $$createField2_0 = $$createType3;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
-        return new GroupClient($$parsedSource as Partial<GroupClient>);
+        if ("messages" in $$parsedSource) {
+            $$parsedSource["messages"] = $$createField2_0($$parsedSource["messages"]);
+        }
+        return new TailMessagesEvent($$parsedSource as Partial<TailMessagesEvent>);
     }
 }
 
 /**
- * GroupStatus 消费者组状态
+ * TailRequest 实时跟踪 Topic 新消息的请求
  */
-export enum GroupStatus {
+export class TailRequest {
     /**
-     * The Go zero value for the underlying type of the enum.
+     * Topic 名称
      */
-    $zero = "",
+    "topic": string;
 
-    GroupOnline = "online",
-    GroupWarning = "warning",
-    GroupOffline = "offline",
-};
+    /**
+     * Tag 过滤表达式，如 TagA || TagB，为空或 * 表示全部
+     */
+    "tags": string;
 
-/**
- * GroupSubscription 订阅关系
- */
-export class GroupSubscription {
     /**
-     * Topic 名称
+     * 每秒最多拉取的消息数，超出部分跳过，<=0 表示默认值
      */
-    "topic": string;
+    "maxRatePerSecond": number;
 
     /**
-     * 过滤表达式
+     * 轮询间隔(毫秒)，<=0 表示默认值
      */
-    "expression": string;
+    "intervalMillis": number;
 
     /**
-     * 消费 TPS
+     * 最长跟踪时间(秒)，到期自动停止，<=0 表示默认值
      */
-    "consumeTps": number;
+    "maxDurationSeconds": number;
 
-    /** Creates a new GroupSubscription instance. */
-    constructor($$source: Partial<GroupSubscription> = {}) {
+    /** Creates a new TailRequest instance. */
+    constructor($$source: Partial<TailRequest> = {}) {
         if (!("topic" in $$source)) {
             this["topic"] = "";
         }
-        if (!("expression" in $$source)) {
-            this["expression"] = "";
+        if (!("tags" in $$source)) {
+            this["tags"] = "";
         }
-        if (!("consumeTps" in $$source)) {
-            this["consumeTps"] = 0;
+        if (!("maxRatePerSecond" in $$source)) {
+            this["maxRatePerSecond"] = 0;
+        }
+        if (!("intervalMillis" in $$source)) {
+            this["intervalMillis"] = 0;
+        }
+        if (!("maxDurationSeconds" in $$source)) {
+            this["maxDurationSeconds"] = 0;
         }
 
         Object.assign(this, $$source);
     }
 
     /**
-     * Creates a new GroupSubscription instance from a string or object.
+     * Creates a new TailRequest instance from a string or object.
      */
-    static createFrom($$source: any = {}): GroupSubscription {
+    static createFrom($$source: any = {}): TailRequest {
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
-        return new GroupSubscription($$parsedSource as Partial<GroupSubscription>);
+        return new TailRequest($$parsedSource as Partial<TailRequest>);
     }
 }
 
 /**
- * MessageItem 消息信息
+ * TailStatus 实时跟踪状态
  */
-export class MessageItem {
+export class TailStatus {
     /**
-     * 消息序号
+     * 跟踪ID
      */
-    "id": number;
+    "tailId": string;
 
     /**
-     * 所属集群
+     * Topic 名称
      */
-    "cluster": string;
+    "topic": string;
 
     /**
-     * Topic 名称
+     * Tag 过滤表达式
      */
-    "topic": string;
+    "tags": string;
 
     /**
-     * 消息ID
+     * 是否运行中
      */
-    "messageId": string;
+    "running": boolean;
 
     /**
-     * 消息标签
+     * 开始时间
      */
-    "tags": string;
+    "startedAt": string;
 
     /**
-     * 消息Keys
+     * 已拉取的消息数
      */
-    "keys": string;
+    "received": number;
 
     /**
-     * 生产者组
+     * 已推送的消息数
      */
-    "producerGroup": string;
+    "emitted": number;
 
     /**
-     * 队列ID
+     * 被 Tag 过滤的消息数
      */
-    "queueId": number;
+    "filtered": number;
 
     /**
-     * 队列偏移
+     * 因超出速率上限被跳过的消息数
      */
-    "queueOffset": number;
+    "dropped": number;
 
     /**
-     * 存储节点
+     * 最近一次错误
      */
-    "storeHost": string;
+    "lastError": string;
 
     /**
-     * 生产节点
+     * 停止原因
      */
-    "bornHost": string;
+    "stopReason": string;
+
+    /** Creates a new TailStatus instance. */
+    constructor($$source: Partial<TailStatus> = {}) {
+        if (!("tailId" in $$source)) {
+            this["tailId"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("tags" in $$source)) {
+            this["tags"] = "";
+        }
+        if (!("running" in $$source)) {
+            this["running"] = false;
+        }
+        if (!("startedAt" in $$source)) {
+            this["startedAt"] = "";
+        }
+        if (!("received" in $$source)) {
+            this["received"] = 0;
+        }
+        if (!("emitted" in $$source)) {
+            this["emitted"] = 0;
+        }
+        if (!("filtered" in $$source)) {
+            this["filtered"] = 0;
+        }
+        if (!("dropped" in $$source)) {
+            this["dropped"] = 0;
+        }
+        if (!("lastError" in $$source)) {
+            this["lastError"] = "";
+        }
+        if (!("stopReason" in $$source)) {
+            this["stopReason"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
 
     /**
-     * 存储时间
+     * Creates a new TailStatus instance from a string or object.
      */
-    "storeTime": string;
+    static createFrom($$source: any = {}): TailStatus {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new TailStatus($$parsedSource as Partial<TailStatus>);
+    }
+}
 
+/**
+ * TopicAllocation 单个 Topic 的队列分配视图
+ */
+export class TopicAllocation {
     /**
-     * 存储时间戳
+     * Topic 名称
      */
-    "storeTimestamp": number;
+    "topic": string;
 
     /**
-     * 消息状态
+     * 队列数
      */
-    "status": MessageStatus;
+    "queueCount": number;
 
     /**
-     * 重试次数
+     * 订阅该 Topic 的客户端数
      */
-    "retryTimes": number;
+    "clientCount": number;
 
     /**
-     * 消息体
+     * 未分配队列数
      */
-    "body": string;
+    "unassignedQueues": number;
 
     /**
-     * 消息属性
+     * 未分配到队列的客户端
      */
-    "properties": { [_ in string]?: string };
+    "idleClients": string[];
 
-    /** Creates a new MessageItem instance. */
-    constructor($$source: Partial<MessageItem> = {}) {
-        if (!("id" in $$source)) {
-            this["id"] = 0;
-        }
-        if (!("cluster" in $$source)) {
-            this["cluster"] = "";
-        }
+    /**
+     * 是否分配不均
+     */
+    "imbalanced": boolean;
+
+    /**
+     * 各队列分配情况
+     */
+    "queues": QueueAssignment[];
+
+    /**
+     * 各客户端负载
+     */
+    "clients": ClientQueueLoad[];
+
+    /** Creates a new TopicAllocation instance. */
+    constructor($$source: Partial<TopicAllocation> = {}) {
         if (!("topic" in $$source)) {
             this["topic"] = "";
         }
-        if (!("messageId" in $$source)) {
-            this["messageId"] = "";
+        if (!("queueCount" in $$source)) {
+            this["queueCount"] = 0;
         }
-        if (!("tags" in $$source)) {
-            this["tags"] = "";
+        if (!("clientCount" in $$source)) {
+            this["clientCount"] = 0;
         }
-        if (!("keys" in $$source)) {
-            this["keys"] = "";
+        if (!("unassignedQueues" in $$source)) {
+            this["unassignedQueues"] = 0;
         }
-        if (!("producerGroup" in $$source)) {
-            this["producerGroup"] = "";
+        if (!("idleClients" in $$source)) {
+            this["idleClients"] = [];
         }
-        if (!("queueId" in $$source)) {
-            this["queueId"] = 0;
+        if (!("imbalanced" in $$source)) {
+            this["imbalanced"] = false;
         }
-        if (!("queueOffset" in $$source)) {
-            this["queueOffset"] = 0;
+        if (!("queues" in $$source)) {
+            this["queues"] = [];
         }
-        if (!("storeHost" in $$source)) {
-            this["storeHost"] = "";
+        if (!("clients" in $$source)) {
+            this["clients"] = [];
         }
-        if (!("bornHost" in $$source)) {
-            this["bornHost"] = "";
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new TopicAllocation instance from a string or object.
+     */
+    static createFrom($$source: any = {}): TopicAllocation {
+        const $$createField4_0 = $$createType0;
+        const $$createField6_0 = $$createType79;
+        const $$createField7_0 = $$createType81;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("idleClients" in $$parsedSource) {
+            $$parsedSource["idleClients"] = $$createField4_0($$parsedSource["idleClients"]);
         }
-        if (!("storeTime" in $$source)) {
-            this["storeTime"] = "";
+        if ("queues" in $$parsedSource) {
+            $$parsedSource["queues"] = $$createField6_0($$parsedSource["queues"]);
         }
-        if (!("storeTimestamp" in $$source)) {
-            this["storeTimestamp"] = 0;
+        if ("clients" in $$parsedSource) {
+            $$parsedSource["clients"] = $$createField7_0($$parsedSource["clients"]);
         }
-        if (!("status" in $$source)) {
-            this["status"] = MessageStatus.$zero;
+        return new TopicAllocation($$parsedSource as Partial<TopicAllocation>);
+    }
+}
+
+/**
+ * TopicCleanupResult Topic 清理结果
+ */
+export class TopicCleanupResult {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 是否成功
+     */
+    "success": boolean;
+
+    /**
+     * 是否跳过（Topic 不存在或消费者组未删除干净）
+     */
+    "skipped": boolean;
+
+    /**
+     * 错误信息
+     */
+    "error": string;
+
+    /** Creates a new TopicCleanupResult instance. */
+    constructor($$source: Partial<TopicCleanupResult> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
         }
-        if (!("retryTimes" in $$source)) {
-            this["retryTimes"] = 0;
+        if (!("success" in $$source)) {
+            this["success"] = false;
         }
-        if (!("body" in $$source)) {
-            this["body"] = "";
+        if (!("skipped" in $$source)) {
+            this["skipped"] = false;
         }
-        if (!("properties" in $$source)) {
-            this["properties"] = {};
+        if (!("error" in $$source)) {
+            this["error"] = "";
         }
 
         Object.assign(this, $$source);
     }
 
     /**
-     * Creates a new MessageItem instance from a string or object.
+     * Creates a new TopicCleanupResult instance from a string or object.
      */
-    static createFrom($$source: any = {}): MessageItem {
-        const $$createField16_0 = $$createType9;
+    static createFrom($$source: any = {}): TopicCleanupResult {
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
-        if ("properties" in $$parsedSource) {
-            $$parsedSource["properties"] = $$createField16_0($$parsedSource["properties"]);
-        }
-        return new MessageItem($$parsedSource as Partial<MessageItem>);
+        return new TopicCleanupResult($$parsedSource as Partial<TopicCleanupResult>);
     }
 }
 
 /**
- * MessageStatus 消息状态
+ * TopicConsumeStatus 客户端在单个 Topic 上的消费状态
  */
-export enum MessageStatus {
+export class TopicConsumeStatus {
     /**
-     * The Go zero value for the underlying type of the enum.
+     * Topic 名称
      */
-    $zero = "",
-
-    MsgNormal = "normal",
-    MsgRetry = "retry",
-    MsgDLQ = "dlq",
-};
+    "topic": string;
 
-/**
- * NameServerNode NameServer 节点信息
- */
-export class NameServerNode {
     /**
-     * 节点ID
+     * 拉取 TPS
      */
-    "id": number;
+    "pullTps": number;
 
     /**
-     * 所属集群
+     * 拉取耗时(毫秒)
      */
-    "cluster": string;
+    "pullRt": number;
 
     /**
-     * 节点地址
+     * 消费成功 TPS
      */
-    "address": string;
+    "consumeOkTps": number;
 
     /**
-     * 版本号
+     * 消费失败 TPS
      */
-    "version": string;
+    "consumeFailedTps": number;
 
     /**
-     * 节点状态
+     * 消费耗时(毫秒)
      */
-    "status": NodeStatus;
+    "consumeRt": number;
 
     /**
-     * 最后可见时间
+     * 最近一小时消费失败消息数
      */
-    "lastSeen": string;
+    "consumeFailedMsgs": number;
 
-    /** Creates a new NameServerNode instance. */
-    constructor($$source: Partial<NameServerNode> = {}) {
-        if (!("id" in $$source)) {
-            this["id"] = 0;
+    /** Creates a new TopicConsumeStatus instance. */
+    constructor($$source: Partial<TopicConsumeStatus> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
         }
-        if (!("cluster" in $$source)) {
-            this["cluster"] = "";
+        if (!("pullTps" in $$source)) {
+            this["pullTps"] = 0;
         }
-        if (!("address" in $$source)) {
-            this["address"] = "";
+        if (!("pullRt" in $$source)) {
+            this["pullRt"] = 0;
         }
-        if (!("version" in $$source)) {
-            this["version"] = "";
+        if (!("consumeOkTps" in $$source)) {
+            this["consumeOkTps"] = 0;
         }
-        if (!("status" in $$source)) {
-            this["status"] = NodeStatus.$zero;
+        if (!("consumeFailedTps" in $$source)) {
+            this["consumeFailedTps"] = 0;
         }
-        if (!("lastSeen" in $$source)) {
-            this["lastSeen"] = "";
+        if (!("consumeRt" in $$source)) {
+            this["consumeRt"] = 0;
+        }
+        if (!("consumeFailedMsgs" in $$source)) {
+            this["consumeFailedMsgs"] = 0;
         }
 
         Object.assign(this, $$source);
     }
 
     /**
-     * Creates a new NameServerNode instance from a string or object.
-     */
-    static createFrom($$source: any = {}): NameServerNode {
-        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
-        return new NameServerNode($$parsedSource as Partial<NameServerNode>);
-    }
-}
-
-/**
- * NodeStatus 节点状态
- */
-export enum NodeStatus {
-    /**
-     * The Go zero value for the underlying type of the enum.
-     */
-    $zero = "",
-
-    NodeOnline = "online",
-    NodeWarning = "warning",
-    NodeOffline = "offline",
-};
+     * Creates a new TopicConsumeStatus instance from a string or object.
+     */
+    static createFrom($$source: any = {}): TopicConsumeStatus {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new TopicConsumeStatus($$parsedSource as Partial<TopicConsumeStatus>);
+    }
+}
 
 /**
  * TopicItem Topic 信息
@@ -1153,7 +8087,7 @@ export class TopicItem {
      * Creates a new TopicItem instance from a string or object.
      */
     static createFrom($$source: any = {}): TopicItem {
-        const $$createField12_0 = $$createType11;
+        const $$createField12_0 = $$createType83;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("routes" in $$parsedSource) {
             $$parsedSource["routes"] = $$createField12_0($$parsedSource["routes"]);
@@ -1191,6 +8125,45 @@ export enum TopicPerm {
     PermDeny = "DENY",
 };
 
+/**
+ * TopicProducers Topic 的生产者组
+ */
+export class TopicProducers {
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 连接到该 Topic 所在 Broker 的生产者组
+     */
+    "groups": (ProducerGroupItem | null)[];
+
+    /** Creates a new TopicProducers instance. */
+    constructor($$source: Partial<TopicProducers> = {}) {
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("groups" in $$source)) {
+            this["groups"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new TopicProducers instance from a string or object.
+     */
+    static createFrom($$source: any = {}): TopicProducers {
+        const $$createField1_0 = $$createType86;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("groups" in $$parsedSource) {
+            $$parsedSource["groups"] = $$createField1_0($$parsedSource["groups"]);
+        }
+        return new TopicProducers($$parsedSource as Partial<TopicProducers>);
+    }
+}
+
 /**
  * TopicRouteItem Topic 路由条目
  */
@@ -1250,16 +8223,455 @@ export class TopicRouteItem {
     }
 }
 
+/**
+ * TraceEvent 消息轨迹事件
+ */
+export class TraceEvent {
+    /**
+     * 事件类型
+     */
+    "type": TraceType;
+
+    /**
+     * 事件时间戳(毫秒)
+     */
+    "timestamp": number;
+
+    /**
+     * 事件时间
+     */
+    "time": string;
+
+    /**
+     * 生产者组或消费者组
+     */
+    "group": string;
+
+    /**
+     * Topic 名称
+     */
+    "topic": string;
+
+    /**
+     * 消息ID
+     */
+    "msgId": string;
+
+    /**
+     * 偏移消息ID
+     */
+    "offsetMsgId": string;
+
+    /**
+     * 消息标签
+     */
+    "tags": string;
+
+    /**
+     * 消息Keys
+     */
+    "keys": string;
+
+    /**
+     * 存储节点
+     */
+    "storeHost": string;
+
+    /**
+     * 上报轨迹的客户端地址
+     */
+    "clientHost": string;
+
+    /**
+     * 消息体长度
+     */
+    "bodyLength": number;
+
+    /**
+     * 耗时(毫秒)
+     */
+    "costTime": number;
+
+    /**
+     * 是否成功
+     */
+    "success": boolean;
+
+    /**
+     * 消息类型
+     */
+    "msgType": string;
+
+    /**
+     * 消费请求ID（关联 SubBefore/SubAfter）
+     */
+    "requestId": string;
+
+    /**
+     * 重试次数
+     */
+    "retryTimes": number;
+
+    /**
+     * 消费结果码
+     */
+    "contextCode": number;
+
+    /**
+     * 事务ID
+     */
+    "transactionId": string;
+
+    /**
+     * 事务状态
+     */
+    "transactionState": string;
+
+    /**
+     * 是否来自事务回查
+     */
+    "fromCheck": boolean;
+
+    /** Creates a new TraceEvent instance. */
+    constructor($$source: Partial<TraceEvent> = {}) {
+        if (!("type" in $$source)) {
+            this["type"] = TraceType.$zero;
+        }
+        if (!("timestamp" in $$source)) {
+            this["timestamp"] = 0;
+        }
+        if (!("time" in $$source)) {
+            this["time"] = "";
+        }
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("topic" in $$source)) {
+            this["topic"] = "";
+        }
+        if (!("msgId" in $$source)) {
+            this["msgId"] = "";
+        }
+        if (!("offsetMsgId" in $$source)) {
+            this["offsetMsgId"] = "";
+        }
+        if (!("tags" in $$source)) {
+            this["tags"] = "";
+        }
+        if (!("keys" in $$source)) {
+            this["keys"] = "";
+        }
+        if (!("storeHost" in $$source)) {
+            this["storeHost"] = "";
+        }
+        if (!("clientHost" in $$source)) {
+            this["clientHost"] = "";
+        }
+        if (!("bodyLength" in $$source)) {
+            this["bodyLength"] = 0;
+        }
+        if (!("costTime" in $$source)) {
+            this["costTime"] = 0;
+        }
+        if (!("success" in $$source)) {
+            this["success"] = false;
+        }
+        if (!("msgType" in $$source)) {
+            this["msgType"] = "";
+        }
+        if (!("requestId" in $$source)) {
+            this["requestId"] = "";
+        }
+        if (!("retryTimes" in $$source)) {
+            this["retryTimes"] = 0;
+        }
+        if (!("contextCode" in $$source)) {
+            this["contextCode"] = 0;
+        }
+        if (!("transactionId" in $$source)) {
+            this["transactionId"] = "";
+        }
+        if (!("transactionState" in $$source)) {
+            this["transactionState"] = "";
+        }
+        if (!("fromCheck" in $$source)) {
+            this["fromCheck"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new TraceEvent instance from a string or object.
+     */
+    static createFrom($$source: any = {}): TraceEvent {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new TraceEvent($$parsedSource as Partial<TraceEvent>);
+    }
+}
+
+/**
+ * TraceGroupTimeline 单个生产者组或消费者组的轨迹时间线
+ */
+export class TraceGroupTimeline {
+    /**
+     * 组名称
+     */
+    "group": string;
+
+    /**
+     * 角色
+     */
+    "role": TraceRole;
+
+    /**
+     * 最终状态：success/failed/consuming
+     */
+    "status": string;
+
+    /**
+     * 累计耗时(毫秒)
+     */
+    "totalCost": number;
+
+    /**
+     * 发送或消费次数
+     */
+    "attempts": number;
+
+    /**
+     * 涉及的客户端地址
+     */
+    "clientHosts": string[];
+
+    /**
+     * 按时间排序的事件
+     */
+    "events": TraceEvent[];
+
+    /** Creates a new TraceGroupTimeline instance. */
+    constructor($$source: Partial<TraceGroupTimeline> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("role" in $$source)) {
+            this["role"] = TraceRole.$zero;
+        }
+        if (!("status" in $$source)) {
+            this["status"] = "";
+        }
+        if (!("totalCost" in $$source)) {
+            this["totalCost"] = 0;
+        }
+        if (!("attempts" in $$source)) {
+            this["attempts"] = 0;
+        }
+        if (!("clientHosts" in $$source)) {
+            this["clientHosts"] = [];
+        }
+        if (!("events" in $$source)) {
+            this["events"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new TraceGroupTimeline instance from a string or object.
+     */
+    static createFrom($$source: any = {}): TraceGroupTimeline {
+        const $$createField5_0 = $$createType0;
+        const $$createField6_0 = $$createType88;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("clientHosts" in $$parsedSource) {
+            $$parsedSource["clientHosts"] = $$createField5_0($$parsedSource["clientHosts"]);
+        }
+        if ("events" in $$parsedSource) {
+            $$parsedSource["events"] = $$createField6_0($$parsedSource["events"]);
+        }
+        return new TraceGroupTimeline($$parsedSource as Partial<TraceGroupTimeline>);
+    }
+}
+
+/**
+ * TraceRole 轨迹时间线所属角色
+ */
+export enum TraceRole {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    TraceRoleProducer = "producer",
+    TraceRoleConsumer = "consumer",
+};
+
+/**
+ * TraceType 消息轨迹事件类型
+ */
+export enum TraceType {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * 生产者发送
+     */
+    TracePub = "Pub",
+
+    /**
+     * 消费者开始消费
+     */
+    TraceSubBefore = "SubBefore",
+
+    /**
+     * 消费者消费完成
+     */
+    TraceSubAfter = "SubAfter",
+
+    /**
+     * 事务消息提交/回滚
+     */
+    TraceEndTransaction = "EndTransaction",
+};
+
+/**
+ * UnlockQueuesRequest 强制解锁队列请求
+ */
+export class UnlockQueuesRequest {
+    /**
+     * 消费者组名称
+     */
+    "group": string;
+
+    /**
+     * 需要解锁的队列
+     */
+    "queues": QueueUnlockTarget[];
+
+    /**
+     * 保护模式下需输入消费者组名称确认
+     */
+    "confirm": string;
+
+    /** Creates a new UnlockQueuesRequest instance. */
+    constructor($$source: Partial<UnlockQueuesRequest> = {}) {
+        if (!("group" in $$source)) {
+            this["group"] = "";
+        }
+        if (!("queues" in $$source)) {
+            this["queues"] = [];
+        }
+        if (!("confirm" in $$source)) {
+            this["confirm"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new UnlockQueuesRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): UnlockQueuesRequest {
+        const $$createField1_0 = $$createType90;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("queues" in $$parsedSource) {
+            $$parsedSource["queues"] = $$createField1_0($$parsedSource["queues"]);
+        }
+        return new UnlockQueuesRequest($$parsedSource as Partial<UnlockQueuesRequest>);
+    }
+}
+
 // Private type creation functions
 const $$createType0 = $Create.Array($Create.Any);
-const $$createType1 = $Create.Array($Create.Any);
-const $$createType2 = BrokerNode.createFrom;
-const $$createType3 = $Create.Nullable($$createType2);
-const $$createType4 = $Create.Array($$createType3);
+const $$createType1 = MessageItem.createFrom;
+const $$createType2 = $Create.Nullable($$createType1);
+const $$createType3 = $Create.Array($$createType2);
+const $$createType4 = $Create.Array($Create.Any);
 const $$createType5 = GroupSubscription.createFrom;
 const $$createType6 = $Create.Array($$createType5);
-const $$createType7 = GroupClient.createFrom;
-const $$createType8 = $Create.Array($$createType7);
-const $$createType9 = $Create.Map($Create.Any, $Create.Any);
-const $$createType10 = TopicRouteItem.createFrom;
-const $$createType11 = $Create.Array($$createType10);
+const $$createType7 = BrokerNode.createFrom;
+const $$createType8 = $Create.Nullable($$createType7);
+const $$createType9 = $Create.Array($$createType8);
+const $$createType10 = $Create.Map($Create.Any, $Create.Any);
+const $$createType11 = GroupClient.createFrom;
+const $$createType12 = $Create.Array($$createType11);
+const $$createType13 = ProcessQueueItem.createFrom;
+const $$createType14 = $Create.Array($$createType13);
+const $$createType15 = TopicConsumeStatus.createFrom;
+const $$createType16 = $Create.Array($$createType15);
+const $$createType17 = DLQQueryParams.createFrom;
+const $$createType18 = DLQRedeliverItem.createFrom;
+const $$createType19 = $Create.Array($$createType18);
+const $$createType20 = DecoderConfig.createFrom;
+const $$createType21 = $Create.Nullable($$createType20);
+const $$createType22 = BrokerOperationResult.createFrom;
+const $$createType23 = $Create.Array($$createType22);
+const $$createType24 = TopicCleanupResult.createFrom;
+const $$createType25 = $Create.Array($$createType24);
+const $$createType26 = GroupHealthFinding.createFrom;
+const $$createType27 = $Create.Array($$createType26);
+const $$createType28 = StuckQueue.createFrom;
+const $$createType29 = $Create.Array($$createType28);
+const $$createType30 = GroupBrokerItem.createFrom;
+const $$createType31 = $Create.Array($$createType30);
+const $$createType32 = OffsetDiffItem.createFrom;
+const $$createType33 = $Create.Array($$createType32);
+const $$createType34 = OffsetUpdateResult.createFrom;
+const $$createType35 = $Create.Array($$createType34);
+const $$createType36 = MessageQueryParams.createFrom;
+const $$createType37 = $Create.Nullable($$createType36);
+const $$createType38 = QueueBrowseParams.createFrom;
+const $$createType39 = $Create.Nullable($$createType38);
+const $$createType40 = FilteredMessage.createFrom;
+const $$createType41 = $Create.Array($$createType40);
+const $$createType42 = FilterTagStat.createFrom;
+const $$createType43 = $Create.Array($$createType42);
+const $$createType44 = FilterPropertyStat.createFrom;
+const $$createType45 = $Create.Array($$createType44);
+const $$createType46 = MessageReplayItem.createFrom;
+const $$createType47 = $Create.Array($$createType46);
+const $$createType48 = TraceGroupTimeline.createFrom;
+const $$createType49 = $Create.Array($$createType48);
+const $$createType50 = MessageTrace.createFrom;
+const $$createType51 = $Create.Array($$createType50);
+const $$createType52 = QueueOffset.createFrom;
+const $$createType53 = $Create.Array($$createType52);
+const $$createType54 = PopQueueStats.createFrom;
+const $$createType55 = $Create.Array($$createType54);
+const $$createType56 = ReviveQueueStats.createFrom;
+const $$createType57 = $Create.Array($$createType56);
+const $$createType58 = ProducerClient.createFrom;
+const $$createType59 = $Create.Array($$createType58);
+const $$createType60 = TopicAllocation.createFrom;
+const $$createType61 = $Create.Array($$createType60);
+const $$createType62 = AllocationIssue.createFrom;
+const $$createType63 = $Create.Array($$createType62);
+const $$createType64 = QueueLockItem.createFrom;
+const $$createType65 = $Create.Array($$createType64);
+const $$createType66 = RetryMessageItem.createFrom;
+const $$createType67 = $Create.Array($$createType66);
+const $$createType68 = RetryRateBucket.createFrom;
+const $$createType69 = $Create.Array($$createType68);
+const $$createType70 = PoisonMessageCandidate.createFrom;
+const $$createType71 = $Create.Array($$createType70);
+const $$createType72 = SkipBacklogItem.createFrom;
+const $$createType73 = $Create.Array($$createType72);
+const $$createType74 = ClientSubscription.createFrom;
+const $$createType75 = $Create.Array($$createType74);
+const $$createType76 = SubscriptionIssue.createFrom;
+const $$createType77 = $Create.Array($$createType76);
+const $$createType78 = QueueAssignment.createFrom;
+const $$createType79 = $Create.Array($$createType78);
+const $$createType80 = ClientQueueLoad.createFrom;
+const $$createType81 = $Create.Array($$createType80);
+const $$createType82 = TopicRouteItem.createFrom;
+const $$createType83 = $Create.Array($$createType82);
+const $$createType84 = ProducerGroupItem.createFrom;
+const $$createType85 = $Create.Nullable($$createType84);
+const $$createType86 = $Create.Array($$createType85);
+const $$createType87 = TraceEvent.createFrom;
+const $$createType88 = $Create.Array($$createType87);
+const $$createType89 = QueueUnlockTarget.createFrom;
+const $$createType90 = $Create.Array($$createType89);
@@ -14,6 +14,28 @@ import { Call as $Call, CancellablePromise as $CancellablePromise, Create as $Cr
 // @ts-ignore: Unused imports
 import * as model$0 from "../model/models.js";
 
+/**
+ * CheckSubscriptionConsistency 检查消费者组内各客户端的订阅关系是否一致
+ * 逐个获取客户端上报的订阅数据，标记 Topic、过滤表达式、表达式类型不一致以及订阅了不存在 Topic 的情况。
+ */
+export function CheckSubscriptionConsistency(groupName: string): $CancellablePromise<model$0.SubscriptionCheckResult | null> {
+    return $Call.ByID(4179294906, groupName).then(($result: any) => {
+        return $$createType1($result);
+    });
+}
+
+/**
+ * CloneGroupOffsets 克隆消费者组位点
+ * 在源消费者组所在的每个 Broker 上创建缺失的目标消费者组（沿用源组配置），再逐队列写入源组位点；
+ * 创建失败的 Broker 记入 FailedBrokers，其上的队列跳过写入。
+ * 目标消费者组有在线客户端时默认拒绝执行，force 为 true 时忽略该检查。
+ */
+export function CloneGroupOffsets(sourceGroup: string, targetGroup: string, topics: string[], force: boolean): $CancellablePromise<model$0.GroupOffsetCloneResult | null> {
+    return $Call.ByID(1022637399, sourceGroup, targetGroup, topics, force).then(($result: any) => {
+        return $$createType3($result);
+    });
+}
+
 /**
  * CreateConsumerGroup 创建消费者组
  */
@@ -21,6 +43,27 @@ export function CreateConsumerGroup(group: string, brokerAddr: string, consumeMo
     return $Call.ByID(91031836, group, brokerAddr, consumeMode, maxRetry);
 }
 
+/**
+ * CreateConsumerGroupWithConfig 按完整配置创建消费者组
+ * 指定 BrokerAddr 时仅在该 Broker 上创建；否则在 Cluster 内（为空时为所有集群）的全部 Master 上创建。
+ * Fields 为空时写入全部字段，否则只写入列出的字段，其余字段在新 Broker 上取默认配置；
+ * 已存在该消费者组的 Broker 会在原配置基础上更新，不会丢失未写入的字段。
+ */
+export function CreateConsumerGroupWithConfig(config: model$0.ConsumerGroupConfig): $CancellablePromise<model$0.BrokerOperationResult[]> {
+    return $Call.ByID(4241344914, config).then(($result: any) => {
+        return $$createType5($result);
+    });
+}
+
+/**
+ * CreateOffsetSnapshot 采集消费者组当前的全部消费位点并保存为本地快照
+ */
+export function CreateOffsetSnapshot(group: string, remark: string): $CancellablePromise<model$0.OffsetSnapshotInfo | null> {
+    return $Call.ByID(874993546, group, remark).then(($result: any) => {
+        return $$createType7($result);
+    });
+}
+
 /**
  * DeleteConsumerGroup 删除消费者组
  */
@@ -28,12 +71,39 @@ export function DeleteConsumerGroup(group: string, brokerAddr: string): $Cancell
     return $Call.ByID(4171083873, group, brokerAddr);
 }
 
+/**
+ * DeleteConsumerGroupEverywhere 在所有存在该消费者组的 Broker 上删除消费者组
+ * 可选同时删除重试 Topic、死信 Topic 以及 Broker 上保存的消费位点；有在线客户端时默认拒绝执行，
+ * 保护模式下需输入消费者组名称确认。任一 Broker 删除失败时不清理 Topic，避免仍存在的消费者组丢失重试与死信消息。
+ */
+export function DeleteConsumerGroupEverywhere(req: model$0.DeleteGroupRequest): $CancellablePromise<model$0.DeleteGroupResult | null> {
+    return $Call.ByID(4245410715, req).then(($result: any) => {
+        return $$createType9($result);
+    });
+}
+
+/**
+ * DeleteOffsetSnapshot 删除位点快照
+ */
+export function DeleteOffsetSnapshot(snapshotID: string): $CancellablePromise<void> {
+    return $Call.ByID(4150615289, snapshotID);
+}
+
+/**
+ * DiffOffsetSnapshot 对比两个位点快照，targetID 为空时与消费者组当前位点对比
+ */
+export function DiffOffsetSnapshot(baseID: string, targetID: string): $CancellablePromise<model$0.OffsetDiffItem[]> {
+    return $Call.ByID(702645729, baseID, targetID).then(($result: any) => {
+        return $$createType11($result);
+    });
+}
+
 /**
  * GetConsumeStats 获取消费统计信息
  */
 export function GetConsumeStats(groupName: string): $CancellablePromise<{ [_ in string]?: any }> {
     return $Call.ByID(1667646038, groupName).then(($result: any) => {
-        return $$createType0($result);
+        return $$createType12($result);
     });
 }
 
@@ -42,7 +112,17 @@ export function GetConsumeStats(groupName: string): $CancellablePromise<{ [_ in
  */
 export function GetConsumerClients(groupName: string): $CancellablePromise<model$0.GroupClient[]> {
     return $Call.ByID(1977653181, groupName).then(($result: any) => {
-        return $$createType2($result);
+        return $$createType14($result);
+    });
+}
+
+/**
+ * GetConsumerGroupConfig 读取消费者组当前配置
+ * 以第一个存在该消费者组的 Broker 上的配置为准，Brokers 字段列出所有存在该消费者组的 Broker。
+ */
+export function GetConsumerGroupConfig(groupName: string): $CancellablePromise<model$0.ConsumerGroupConfig | null> {
+    return $Call.ByID(3845530204, groupName).then(($result: any) => {
+        return $$createType16($result);
     });
 }
 
@@ -51,7 +131,7 @@ export function GetConsumerClients(groupName: string): $CancellablePromise<model
  */
 export function GetConsumerGroupDetail(groupName: string): $CancellablePromise<model$0.ConsumerGroupItem | null> {
     return $Call.ByID(1876345635, groupName).then(($result: any) => {
-        return $$createType4($result);
+        return $$createType18($result);
     });
 }
 
@@ -60,7 +140,107 @@ export function GetConsumerGroupDetail(groupName: string): $CancellablePromise<m
  */
 export function GetConsumerGroups(): $CancellablePromise<(model$0.ConsumerGroupItem | null)[]> {
     return $Call.ByID(1865015347).then(($result: any) => {
-        return $$createType5($result);
+        return $$createType19($result);
+    });
+}
+
+/**
+ * GetConsumerRunningInfo 获取消费者客户端运行时信息
+ * withJstack 为 true 时请求客户端返回线程栈，客户端不支持时 Jstack 为空。
+ */
+export function GetConsumerRunningInfo(groupName: string, clientID: string, withJstack: boolean): $CancellablePromise<model$0.ConsumerRunningDetail | null> {
+    return $Call.ByID(1271903042, groupName, clientID, withJstack).then(($result: any) => {
+        return $$createType21($result);
+    });
+}
+
+/**
+ * GetGroupHealth 汇总消费者组健康检查结论
+ * 状态与 GetConsumerGroups 一致，按是否有在线客户端判断在线或离线，在线且存在卡住队列时为告警。
+ * 卡住队列的结论来自已开启的卡住消费检测，未开启时给出提示。
+ */
+export function GetGroupHealth(group: string): $CancellablePromise<model$0.GroupHealth | null> {
+    return $Call.ByID(1383167032, group).then(($result: any) => {
+        return $$createType23($result);
+    });
+}
+
+/**
+ * GetOffsetSnapshot 获取位点快照详情
+ */
+export function GetOffsetSnapshot(snapshotID: string): $CancellablePromise<model$0.OffsetSnapshot | null> {
+    return $Call.ByID(3179298812, snapshotID).then(($result: any) => {
+        return $$createType25($result);
+    });
+}
+
+/**
+ * GetPopConsumeStats 获取消费者组的 Pop 消费统计
+ * 不可见消息数为已 Pop 位点与已确认位点之差；Revive 进度为 Broker 处理 Pop 超时重投的进度，按集群汇总。
+ */
+export function GetPopConsumeStats(groupName: string): $CancellablePromise<model$0.PopConsumeStats | null> {
+    return $Call.ByID(2360757195, groupName).then(($result: any) => {
+        return $$createType27($result);
+    });
+}
+
+/**
+ * GetQueueAllocation 获取消费者组各 Topic 的队列到客户端的分配情况
+ * 队列归属来自各客户端上报的处理队列，位点与堆积量来自消费统计；重试 Topic 不参与分析。
+ */
+export function GetQueueAllocation(groupName: string): $CancellablePromise<model$0.QueueAllocationResult | null> {
+    return $Call.ByID(463971504, groupName).then(($result: any) => {
+        return $$createType29($result);
+    });
+}
+
+/**
+ * GetQueueLocks 获取消费者组各队列的顺序消费锁状态
+ * 锁的持有者与续锁时间来自在线客户端上报的处理队列；probe 为 true 时，对没有分配给任何在线客户端的队列
+ * 以临时客户端ID向 Broker 尝试加锁并立即释放，以区分空闲队列和被已下线客户端残留锁住的队列。
+ * 已分配给在线客户端但尚未加锁的队列不会探测，避免探测锁阻塞客户端重平衡时加锁。
+ * 探测会真实占用 Broker 端的锁，释放失败时顺序消费者在锁过期前无法加锁，因此保护模式下探测需输入消费者组名称确认。
+ */
+export function GetQueueLocks(groupName: string, probe: boolean, confirm: string): $CancellablePromise<model$0.QueueLockResult | null> {
+    return $Call.ByID(2363318370, groupName, probe, confirm).then(($result: any) => {
+        return $$createType31($result);
+    });
+}
+
+/**
+ * GetStuckDetectionStatus 获取消费者组卡住消费检测的当前状态
+ */
+export function GetStuckDetectionStatus(group: string): $CancellablePromise<model$0.StuckDetectionStatus | null> {
+    return $Call.ByID(1287242234, group).then(($result: any) => {
+        return $$createType33($result);
+    });
+}
+
+/**
+ * ListOffsetSnapshots 列出本地位点快照，group 为空时列出全部
+ */
+export function ListOffsetSnapshots(group: string): $CancellablePromise<(model$0.OffsetSnapshotInfo | null)[]> {
+    return $Call.ByID(1345294095, group).then(($result: any) => {
+        return $$createType34($result);
+    });
+}
+
+/**
+ * PreviewCloneGroupOffsets 预览将源消费者组位点克隆到目标消费者组的效果
+ * topics 为空时包含源消费者组的全部 Topic。
+ */
+export function PreviewCloneGroupOffsets(sourceGroup: string, targetGroup: string, topics: string[]): $CancellablePromise<model$0.GroupOffsetClonePlan | null> {
+    return $Call.ByID(4207837761, sourceGroup, targetGroup, topics).then(($result: any) => {
+        return $$createType36($result);
+    });
+}
+
+/**
+ * PreviewSkipBacklog 预览跳过堆积的效果，topic 为空时包含消费者组订阅的全部 Topic（不含重试 Topic）
+ */
+export function PreviewSkipBacklog(group: string, topic: string): $CancellablePromise<model$0.SkipBacklogPlan | null> {
+    return $Call.ByID(2992130611, group, topic).then(($result: any) => {
+        return $$createType38($result);
     });
 }
 
@@ -71,10 +251,132 @@ export function ResetOffset(group: string, topic: string, timestamp: number, for
     return $Call.ByID(1452742991, group, topic, timestamp, force);
 }
 
+/**
+ * RestoreOffsetSnapshot 将位点快照写回 Broker
+ * targetGroup 为空时恢复到快照所属消费者组，否则写入指定消费者组（可用于跨集群迁移）。
+ * 消费者组有在线客户端时，客户端提交的位点会覆盖恢复结果，因此默认拒绝执行；force 为 true 时忽略该检查，恢复结果可能不生效。
+ */
+export function RestoreOffsetSnapshot(snapshotID: string, targetGroup: string, force: boolean): $CancellablePromise<model$0.OffsetUpdateResult[]> {
+    return $Call.ByID(2177300814, snapshotID, targetGroup, force).then(($result: any) => {
+        return $$createType40($result);
+    });
+}
+
+/**
+ * SkipBacklog 将消费者组的消费位点推进到队列最大位点，丢弃当前堆积
+ * 执行前自动保存受影响队列的位点快照，可通过 UndoSkipBacklog 撤销。
+ * 有在线客户端时默认拒绝执行；Force 为 true 时通过 Broker 通知客户端重置位点，避免被客户端提交的位点覆盖，
+ * 此时撤销需要先停止所有客户端。
+ */
+export function SkipBacklog(req: model$0.SkipBacklogRequest): $CancellablePromise<model$0.SkipBacklogResult | null> {
+    return $Call.ByID(1085199937, req).then(($result: any) => {
+        return $$createType42($result);
+    });
+}
+
+/**
+ * StartStuckDetection 开始周期采样消费者组的消费位点，检测长时间未推进且有堆积的队列
+ * 同一消费者组重复调用会以新参数重新开始检测。
+ */
+export function StartStuckDetection(req: model$0.StuckDetectionRequest): $CancellablePromise<void> {
+    return $Call.ByID(2804924216, req);
+}
+
+/**
+ * StopStuckDetection 停止消费者组的卡住消费检测
+ */
+export function StopStuckDetection(group: string): $CancellablePromise<void> {
+    return $Call.ByID(525803478, group);
+}
+
+/**
+ * SwitchConsumeType 在 Broker 上切换消费者组的 Pop/Push 消费方式
+ * 通过设置 Broker 端 MessageRequestMode 实现，仅 RocketMQ 5.x Broker 支持，低版本 Broker 的错误会在结果中返回。
+ */
+export function SwitchConsumeType(req: model$0.SwitchConsumeTypeRequest): $CancellablePromise<model$0.SwitchConsumeTypeResult[]> {
+    return $Call.ByID(2521252873, req).then(($result: any) => {
+        return $$createType44($result);
+    });
+}
+
+/**
+ * UndoSkipBacklog 将消费者组位点恢复到跳过堆积前的自动快照
+ * Broker 通知客户端重置位点只支持按时间戳，无法恢复到快照中的精确位点，而直接写入的位点会被在线客户端
+ * 用内存中的位点覆盖，因此只能在消费者组没有在线客户端时撤销。
+ */
+export function UndoSkipBacklog(snapshotID: string): $CancellablePromise<model$0.OffsetUpdateResult[]> {
+    return $Call.ByID(4151169203, snapshotID).then(($result: any) => {
+        return $$createType40($result);
+    });
+}
+
+/**
+ * UnlockQueues 强制释放队列在 Broker 端的顺序消费锁
+ * Broker 只释放由指定客户端ID持有的锁。未指定 ClientID 时依次使用在线客户端上报的持有者、
+ * 最近一次检查时观察到的持有者；仍未知时探测 Broker 端是否存在锁并说明原因。保护模式下需输入消费者组名称确认。
+ */
+export function UnlockQueues(req: model$0.UnlockQueuesRequest): $CancellablePromise<model$0.QueueUnlockResult[]> {
+    return $Call.ByID(3487148325, req).then(($result: any) => {
+        return $$createType46($result);
+    });
+}
+
+/**
+ * UpdateConsumerGroupConfig 更新消费者组配置
+ * 只更新已存在该消费者组的 Broker（指定 BrokerAddr 时仅更新该 Broker），并以各 Broker 上的当前配置为基础，
+ * 只修改 Fields 中列出的字段，未列出的字段保持 Broker 上的当前值。
+ */
+export function UpdateConsumerGroupConfig(config: model$0.ConsumerGroupConfig): $CancellablePromise<model$0.BrokerOperationResult[]> {
+    return $Call.ByID(1001996101, config).then(($result: any) => {
+        return $$createType5($result);
+    });
+}
+
 // Private type creation functions
-const $$createType0 = $Create.Map($Create.Any, $Create.Any);
-const $$createType1 = model$0.GroupClient.createFrom;
-const $$createType2 = $Create.Array($$createType1);
-const $$createType3 = model$0.ConsumerGroupItem.createFrom;
-const $$createType4 = $Create.Nullable($$createType3);
+const $$createType0 = model$0.SubscriptionCheckResult.createFrom;
+const $$createType1 = $Create.Nullable($$createType0);
+const $$createType2 = model$0.GroupOffsetCloneResult.createFrom;
+const $$createType3 = $Create.Nullable($$createType2);
+const $$createType4 = model$0.BrokerOperationResult.createFrom;
 const $$createType5 = $Create.Array($$createType4);
+const $$createType6 = model$0.OffsetSnapshotInfo.createFrom;
+const $$createType7 = $Create.Nullable($$createType6);
+const $$createType8 = model$0.DeleteGroupResult.createFrom;
+const $$createType9 = $Create.Nullable($$createType8);
+const $$createType10 = model$0.OffsetDiffItem.createFrom;
+const $$createType11 = $Create.Array($$createType10);
+const $$createType12 = $Create.Map($Create.Any, $Create.Any);
+const $$createType13 = model$0.GroupClient.createFrom;
+const $$createType14 = $Create.Array($$createType13);
+const $$createType15 = model$0.ConsumerGroupConfig.createFrom;
+const $$createType16 = $Create.Nullable($$createType15);
+const $$createType17 = model$0.ConsumerGroupItem.createFrom;
+const $$createType18 = $Create.Nullable($$createType17);
+const $$createType19 = $Create.Array($$createType18);
+const $$createType20 = model$0.ConsumerRunningDetail.createFrom;
+const $$createType21 = $Create.Nullable($$createType20);
+const $$createType22 = model$0.GroupHealth.createFrom;
+const $$createType23 = $Create.Nullable($$createType22);
+const $$createType24 = model$0.OffsetSnapshot.createFrom;
+const $$createType25 = $Create.Nullable($$createType24);
+const $$createType26 = model$0.PopConsumeStats.createFrom;
+const $$createType27 = $Create.Nullable($$createType26);
+const $$createType28 = model$0.QueueAllocationResult.createFrom;
+const $$createType29 = $Create.Nullable($$createType28);
+const $$createType30 = model$0.QueueLockResult.createFrom;
+const $$createType31 = $Create.Nullable($$createType30);
+const $$createType32 = model$0.StuckDetectionStatus.createFrom;
+const $$createType33 = $Create.Nullable($$createType32);
+const $$createType34 = $Create.Array($$createType7);
+const $$createType35 = model$0.GroupOffsetClonePlan.createFrom;
+const $$createType36 = $Create.Nullable($$createType35);
+const $$createType37 = model$0.SkipBacklogPlan.createFrom;
+const $$createType38 = $Create.Nullable($$createType37);
+const $$createType39 = model$0.OffsetUpdateResult.createFrom;
+const $$createType40 = $Create.Array($$createType39);
+const $$createType41 = model$0.SkipBacklogResult.createFrom;
+const $$createType42 = $Create.Nullable($$createType41);
+const $$createType43 = model$0.SwitchConsumeTypeResult.createFrom;
+const $$createType44 = $Create.Array($$createType43);
+const $$createType45 = model$0.QueueUnlockResult.createFrom;
+const $$createType46 = $Create.Array($$createType45);
@@ -14,31 +14,157 @@ import { Call as $Call, CancellablePromise as $CancellablePromise, Create as $Cr
 // @ts-ignore: Unused imports
 import * as model$0 from "../model/models.js";
 
+/**
+ * BrowseQueueMessages 按位点顺序浏览单个队列的消息，返回前后翻页的游标
+ */
+export function BrowseQueueMessages(params: model$0.QueueBrowseParams): $CancellablePromise<model$0.QueueBrowseResult | null> {
+    return $Call.ByID(1954330451, params).then(($result: any) => {
+        return $$createType1($result);
+    });
+}
+
+/**
+ * CancelBodySearch 取消正在运行的消息体搜索
+ */
+export function CancelBodySearch(searchID: string): $CancellablePromise<void> {
+    return $Call.ByID(3702369308, searchID);
+}
+
+/**
+ * CancelReplay 取消正在运行的消息回放，已发送的消息不会撤回
+ */
+export function CancelReplay(replayID: string): $CancellablePromise<void> {
+    return $Call.ByID(2457075811, replayID);
+}
+
+/**
+ * DecodeMessageBody 使用指定或 Topic 记住的解码器解码消息体
+ */
+export function DecodeMessageBody(req: model$0.DecodeBodyRequest): $CancellablePromise<model$0.DecodedBody | null> {
+    return $Call.ByID(3517584471, req).then(($result: any) => {
+        return $$createType3($result);
+    });
+}
+
+/**
+ * DecodeMessageID 解析消息ID或唯一键中编码的信息
+ */
+export function DecodeMessageID(msgID: string): $CancellablePromise<model$0.MessageIDInfo | null> {
+    return $Call.ByID(4184845746, msgID).then(($result: any) => {
+        return $$createType5($result);
+    });
+}
+
+/**
+ * ExportMessages 将查询或浏览结果导出为 JSONL 或 CSV 文件
+ * 导出完整的属性与消息体，二进制消息体以 base64 表示，导出文件可用于 StartReplay 回放。
+ */
+export function ExportMessages(req: model$0.MessageExportRequest): $CancellablePromise<model$0.MessageExportResult | null> {
+    return $Call.ByID(2096128832, req).then(($result: any) => {
+        return $$createType7($result);
+    });
+}
+
+/**
+ * FilterMessages 按 Tag 或 SQL92 订阅表达式预览消息过滤结果
+ * 在时间范围或单个队列的位点区间内扫描消息，按 Broker 的过滤语义求值，返回命中与未命中的消息及原因、统计。
+ */
+export function FilterMessages(req: model$0.MessageFilterRequest): $CancellablePromise<model$0.MessageFilterResult | null> {
+    return $Call.ByID(4134520932, req).then(($result: any) => {
+        return $$createType9($result);
+    });
+}
+
+/**
+ * GetBodySearchProgress 获取正在运行的消息体搜索进度
+ */
+export function GetBodySearchProgress(searchID: string): $CancellablePromise<model$0.BodySearchProgress | null> {
+    return $Call.ByID(2006845569, searchID).then(($result: any) => {
+        return $$createType11($result);
+    });
+}
+
+/**
+ * GetDLQRedeliveryRecords 获取消费者组的死信重投记录
+ */
+export function GetDLQRedeliveryRecords(group: string): $CancellablePromise<model$0.RedeliveryRecord[]> {
+    return $Call.ByID(1830027934, group).then(($result: any) => {
+        return $$createType13($result);
+    });
+}
+
 /**
  * GetMessageDetail 获取消息详情
  */
 export function GetMessageDetail(topic: string, msgID: string): $CancellablePromise<model$0.MessageItem | null> {
     return $Call.ByID(826218736, topic, msgID).then(($result: any) => {
-        return $$createType1($result);
+        return $$createType15($result);
     });
 }
 
 /**
- * GetMessageTrack 获取消息轨迹
- * 注意：rocketmq-admin-go 库当前版本暂不支持 MessageTrackDetail
+ * GetMessageTrace 按消息ID或 Key 查询消息轨迹，并按生产者组、消费者组整理为时间线
+ * 轨迹消息以原消息ID和业务 Key 作为索引 Key 写入轨迹 Topic，因此通过 Key 索引查询。
  */
-export function GetMessageTrack(topic: string, msgID: string): $CancellablePromise<{ [_ in string]?: any }[]> {
+export function GetMessageTrace(query: model$0.MessageTraceQuery): $CancellablePromise<model$0.MessageTraceResult | null> {
+    return $Call.ByID(3421811954, query).then(($result: any) => {
+        return $$createType17($result);
+    });
+}
+
+/**
+ * GetMessageTrack 获取消息轨迹（按消息ID查询默认轨迹 Topic）
+ */
+export function GetMessageTrack(topic: string, msgID: string): $CancellablePromise<model$0.MessageTraceResult | null> {
     return $Call.ByID(3455367192, topic, msgID).then(($result: any) => {
-        return $$createType3($result);
+        return $$createType17($result);
+    });
+}
+
+/**
+ * GetReplayProgress 获取正在运行的消息回放进度
+ */
+export function GetReplayProgress(replayID: string): $CancellablePromise<model$0.MessageReplayProgress | null> {
+    return $Call.ByID(3925637142, replayID).then(($result: any) => {
+        return $$createType19($result);
+    });
+}
+
+/**
+ * GetTails 获取正在运行的实时跟踪
+ */
+export function GetTails(): $CancellablePromise<(model$0.TailStatus | null)[]> {
+    return $Call.ByID(406105197).then(($result: any) => {
+        return $$createType22($result);
+    });
+}
+
+/**
+ * GetTopicDecoder 获取 Topic 记住的解码配置，未配置时返回 auto
+ */
+export function GetTopicDecoder(topic: string): $CancellablePromise<model$0.DecoderConfig | null> {
+    return $Call.ByID(1062072431, topic).then(($result: any) => {
+        return $$createType24($result);
+    });
+}
+
+/**
+ * QueryDLQMessages 按时间范围和 Key 浏览消费者组的死信消息
+ */
+export function QueryDLQMessages(params: model$0.DLQQueryParams): $CancellablePromise<model$0.DLQQueryResult | null> {
+    return $Call.ByID(1071131889, params).then(($result: any) => {
+        return $$createType26($result);
     });
 }
 
 /**
  * QueryMessageByID 按消息 ID 查询消息
+ * topic 为空时只支持偏移消息ID：从 ID 中解析存储节点与 CommitLog 偏移后直接查询；
+ * 指定 topic 但查询失败时，若为偏移消息ID也会回退到直接查询。
  */
 export function QueryMessageByID(topic: string, msgID: string): $CancellablePromise<model$0.MessageItem | null> {
     return $Call.ByID(2698022101, topic, msgID).then(($result: any) => {
-        return $$createType1($result);
+        return $$createType15($result);
     });
 }
 
@@ -47,7 +173,39 @@ export function QueryMessageByID(topic: string, msgID: string): $CancellableProm
  */
 export function QueryMessages(topic: string, key: string, maxResults: number): $CancellablePromise<(model$0.MessageItem | null)[]> {
     return $Call.ByID(1433538526, topic, key, maxResults).then(($result: any) => {
-        return $$createType4($result);
+        return $$createType27($result);
+    });
+}
+
+/**
+ * QueryMessagesByTime 按时间范围浏览 Topic 的消息
+ * 按存储时间戳定位每个队列的起止位点后拉取窗口内的消息，合并后按存储时间从新到旧排序分页。
+ * 结果最多保留 MaxResults 条最新的消息，每个队列只需拉取窗口末尾的 MaxResults 条即可保证结果正确。
+ * 首次查询的结果按返回的 QueryID 缓存 5 分钟，翻页时传回 QueryID 即可直接分页，不再重新拉取。
+ */
+export function QueryMessagesByTime(params: model$0.MessageQueryParams): $CancellablePromise<model$0.MessagePage | null> {
+    return $Call.ByID(3160332326, params).then(($result: any) => {
+        return $$createType29($result);
+    });
+}
+
+/**
+ * QueryRetryMessages 浏览消费者组重试 Topic 中的消息并统计重试率
+ * 统计基于时间范围内扫描到的全部重试消息，Messages 仅返回前 MaxResults 条。
+ */
+export function QueryRetryMessages(params: model$0.RetryQueryParams): $CancellablePromise<model$0.RetryQueryResult | null> {
+    return $Call.ByID(2529028236, params).then(($result: any) => {
+        return $$createType31($result);
+    });
+}
+
+/**
+ * RedeliverDLQMessages 将死信消息重新投递到原始 Topic 或消费者组重试 Topic
+ * 按 RatePerSecond 限速逐条发送；已成功重投过的消息会被记录，除非 Force 为 true，否则不会再次投递。
+ */
+export function RedeliverDLQMessages(req: model$0.DLQRedeliverRequest): $CancellablePromise<model$0.DLQRedeliverResult | null> {
+    return $Call.ByID(3695519499, req).then(($result: any) => {
+        return $$createType33($result);
     });
 }
 
@@ -58,9 +216,99 @@ export function ResendMessage(consumerGroup: string, clientID: string, topic: st
     return $Call.ByID(1358375348, consumerGroup, clientID, topic, msgID);
 }
 
+/**
+ * SendMessage 使用默认连接的生产者发送一条消息
+ * 生产者使用连接配置中的 ACL 凭证；指定 BrokerName 时发送到该 Broker 的 QueueID 队列，
+ * 设置 ShardingKey 时按键哈希选择队列以保证同键消息有序。
+ */
+export function SendMessage(req: model$0.SendMessageRequest): $CancellablePromise<model$0.SendMessageResult | null> {
+    return $Call.ByID(1221390803, req).then(($result: any) => {
+        return $$createType35($result);
+    });
+}
+
+/**
+ * SetTopicDecoder 设置并记住 Topic 的解码器，Protobuf/Avro 配置会先编译校验
+ */
+export function SetTopicDecoder(config: model$0.DecoderConfig): $CancellablePromise<model$0.DecoderConfig | null> {
+    return $Call.ByID(673783123, config).then(($result: any) => {
+        return $$createType24($result);
+    });
+}
+
+/**
+ * StartBodySearch 在时间窗口内并行扫描 Topic 各队列，按子串、正则或 JSONPath 搜索消息体
+ * 搜索在后台运行：命中的消息通过 message:search:match 事件分批推送，进度通过 message:search:progress 事件推送，
+ * 达到扫描上限或命中上限时提前结束。消息体按 Topic 记住的 Protobuf/Avro 解码器解码后再匹配。
+ */
+export function StartBodySearch(req: model$0.BodySearchRequest): $CancellablePromise<model$0.BodySearchProgress | null> {
+    return $Call.ByID(4030577850, req).then(($result: any) => {
+        return $$createType11($result);
+    });
+}
+
+/**
+ * StartReplay 将导出文件中的消息回放到指定连接的 Topic
+ * 回放在后台按 RatePerSecond 限速逐条发送，可替换标签、Keys 与属性；DryRun 时只构造消息不发送。
+ * 进度通过 message:replay:progress 事件推送，逐条结果追加写入日志文件，并通过 message:replay:items 事件推送前 1000 条。
+ */
+export function StartReplay(req: model$0.MessageReplayRequest): $CancellablePromise<model$0.MessageReplayProgress | null> {
+    return $Call.ByID(2441102505, req).then(($result: any) => {
+        return $$createType19($result);
+    });
+}
+
+/**
+ * StartTail 开始实时跟踪 Topic 的新消息，新消息通过 message:tail 事件推送
+ * 从各队列当前的最大位点开始，按轮询间隔拉取新写入的消息；超过速率上限的消息直接跳过以保持实时。
+ */
+export function StartTail(req: model$0.TailRequest): $CancellablePromise<model$0.TailStatus | null> {
+    return $Call.ByID(3625178912, req).then(($result: any) => {
+        return $$createType21($result);
+    });
+}
+
+/**
+ * StopTail 停止实时跟踪
+ */
+export function StopTail(tailID: string): $CancellablePromise<void> {
+    return $Call.ByID(2219087368, tailID);
+}
+
 // Private type creation functions
-const $$createType0 = model$0.MessageItem.createFrom;
+const $$createType0 = model$0.QueueBrowseResult.createFrom;
 const $$createType1 = $Create.Nullable($$createType0);
-const $$createType2 = $Create.Map($Create.Any, $Create.Any);
-const $$createType3 = $Create.Array($$createType2);
-const $$createType4 = $Create.Array($$createType1);
+const $$createType2 = model$0.DecodedBody.createFrom;
+const $$createType3 = $Create.Nullable($$createType2);
+const $$createType4 = model$0.MessageIDInfo.createFrom;
+const $$createType5 = $Create.Nullable($$createType4);
+const $$createType6 = model$0.MessageExportResult.createFrom;
+const $$createType7 = $Create.Nullable($$createType6);
+const $$createType8 = model$0.MessageFilterResult.createFrom;
+const $$createType9 = $Create.Nullable($$createType8);
+const $$createType10 = model$0.BodySearchProgress.createFrom;
+const $$createType11 = $Create.Nullable($$createType10);
+const $$createType12 = model$0.RedeliveryRecord.createFrom;
+const $$createType13 = $Create.Array($$createType12);
+const $$createType14 = model$0.MessageItem.createFrom;
+const $$createType15 = $Create.Nullable($$createType14);
+const $$createType16 = model$0.MessageTraceResult.createFrom;
+const $$createType17 = $Create.Nullable($$createType16);
+const $$createType18 = model$0.MessageReplayProgress.createFrom;
+const $$createType19 = $Create.Nullable($$createType18);
+const $$createType20 = model$0.TailStatus.createFrom;
+const $$createType21 = $Create.Nullable($$createType20);
+const $$createType22 = $Create.Array($$createType21);
+const $$createType23 = model$0.DecoderConfig.createFrom;
+const $$createType24 = $Create.Nullable($$createType23);
+const $$createType25 = model$0.DLQQueryResult.createFrom;
+const $$createType26 = $Create.Nullable($$createType25);
+const $$createType27 = $Create.Array($$createType15);
+const $$createType28 = model$0.MessagePage.createFrom;
+const $$createType29 = $Create.Nullable($$createType28);
+const $$createType30 = model$0.RetryQueryResult.createFrom;
+const $$createType31 = $Create.Nullable($$createType30);
+const $$createType32 = model$0.DLQRedeliverResult.createFrom;
+const $$createType33 = $Create.Nullable($$createType32);
+const $$createType34 = model$0.SendMessageResult.createFrom;
+const $$createType35 = $Create.Nullable($$createType34);
@@ -0,0 +1,51 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+/**
+ * ProducerService 生产者组服务
+ * @module
+ */
+
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import { Call as $Call, CancellablePromise as $CancellablePromise, Create as $Create } from "@wailsio/runtime";
+
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import * as model$0 from "../model/models.js";
+
+/**
+ * GetProducerConnections 获取生产者组在 Topic 所在 Broker 上的连接
+ */
+export function GetProducerConnections(group: string, topic: string): $CancellablePromise<model$0.ProducerGroupItem | null> {
+    return $Call.ByID(2708606872, group, topic).then(($result: any) => {
+        return $$createType1($result);
+    });
+}
+
+/**
+ * GetProducerGroups 获取所有 Master Broker 上当前有连接的生产者组
+ * Broker 只保存在线生产者的心跳，没有连接的生产者组不会出现在列表中。
+ */
+export function GetProducerGroups(): $CancellablePromise<(model$0.ProducerGroupItem | null)[]> {
+    return $Call.ByID(3547849379).then(($result: any) => {
+        return $$createType2($result);
+    });
+}
+
+/**
+ * GetTopicProducers 获取向 Topic 所在 Broker 建立了连接的生产者组
+ * Broker 按生产者组而非 Topic 记录连接，groups 为空时先从 Topic 所在 Broker 汇总生产者组再逐个查询。
+ */
+export function GetTopicProducers(topic: string, groups: string[]): $CancellablePromise<model$0.TopicProducers | null> {
+    return $Call.ByID(385614795, topic, groups).then(($result: any) => {
+        return $$createType4($result);
+    });
+}
+
+// Private type creation functions
+const $$createType0 = model$0.ProducerGroupItem.createFrom;
+const $$createType1 = $Create.Nullable($$createType0);
+const $$createType2 = $Create.Array($$createType1);
+const $$createType3 = model$0.TopicProducers.createFrom;
+const $$createType4 = $Create.Nullable($$createType3);
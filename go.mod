@@ -3,8 +3,13 @@ module rocket-leaf
 go 1.25
 
 require (
+	github.com/apache/rocketmq-client-go/v2 v2.1.2
 	github.com/codermast/rocketmq-admin-go v1.0.0
+	github.com/klauspost/compress v1.18.3
+	github.com/linkedin/goavro/v2 v2.12.0
+	github.com/pierrec/lz4/v4 v4.1.33
 	github.com/wailsapp/wails/v3 v3.0.0-alpha.71
+	google.golang.org/protobuf v1.36.9
 )
 
 require (
@@ -12,7 +17,6 @@ require (
 	github.com/Microsoft/go-winio v0.6.2 // indirect
 	github.com/ProtonMail/go-crypto v1.3.0 // indirect
 	github.com/adrg/xdg v0.5.3 // indirect
-	github.com/apache/rocketmq-client-go/v2 v2.1.2 // indirect
 	github.com/bep/debounce v1.2.1 // indirect
 	github.com/cloudflare/circl v1.6.3 // indirect
 	github.com/coder/websocket v1.8.14 // indirect
@@ -26,6 +30,7 @@ require (
 	github.com/godbus/dbus/v5 v5.2.2 // indirect
 	github.com/golang/groupcache v0.0.0-20241129210726-2c02b8208cf8 // indirect
 	github.com/golang/mock v1.3.1 // indirect
+	github.com/golang/snappy v0.0.1 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/jbenet/go-context v0.0.0-20150711004518-d14ea06fba99 // indirect
 	github.com/jchv/go-winloader v0.0.0-20250406163304-c1995be93bd1 // indirect
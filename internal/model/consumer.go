@@ -17,19 +17,33 @@ const (
 	ModeBroadcasting ConsumeMode = "BROADCASTING"
 )
 
+// ConsumeType 消费类型
+type ConsumeType string
+
+const (
+	ConsumeTypePush    ConsumeType = "PUSH"    // Push 消费（CONSUME_PASSIVELY）
+	ConsumeTypePull    ConsumeType = "PULL"    // Pull 消费（CONSUME_ACTIVELY）
+	ConsumeTypePop     ConsumeType = "POP"     // Pop 消费（CONSUME_POP，RocketMQ 5.x）
+	ConsumeTypeUnknown ConsumeType = "UNKNOWN" // 无在线客户端，无法判断
+)
+
 // GroupSubscription 订阅关系
 type GroupSubscription struct {
-	Topic      string `json:"topic"`      // Topic 名称
-	Expression string `json:"expression"` // 过滤表达式
-	ConsumeTps int    `json:"consumeTps"` // 消费 TPS
+	Topic          string `json:"topic"`          // Topic 名称
+	Expression     string `json:"expression"`     // 过滤表达式
+	ExpressionType string `json:"expressionType"` // 表达式类型(TAG/SQL92)
+	ConsumeTps     int    `json:"consumeTps"`     // 消费 TPS
 }
 
 // GroupClient 消费者客户端信息
 type GroupClient struct {
-	ClientID      string `json:"clientId"`      // 客户端ID
-	IP            string `json:"ip"`            // IP 地址
-	Version       string `json:"version"`       // 版本号
-	LastHeartbeat string `json:"lastHeartbeat"` // 最后心跳时间
+	ClientID      string `json:"clientId"`                // 客户端ID
+	IP            string `json:"ip"`                      // IP 地址
+	Language      string `json:"language"`                // 客户端语言
+	Version       string `json:"version"`                 // 版本名称，如 V4_9_4
+	VersionCode   int    `json:"versionCode"`             // 客户端上报的原始版本号
+	Outdated      bool   `json:"outdated"`                // 是否低于推荐版本 rocketmq.MinRecommendedClientVersion，只判断 Java 客户端
+	LastHeartbeat string `json:"lastHeartbeat,omitempty"` // 最后心跳时间，Broker 不提供心跳时间，暂不支持，始终为空
 }
 
 // ConsumerGroupItem 消费者组信息
@@ -38,6 +52,7 @@ type ConsumerGroupItem struct {
 	Group         string              `json:"group"`         // 消费者组名称
 	Cluster       string              `json:"cluster"`       // 所属集群
 	ConsumeMode   ConsumeMode         `json:"consumeMode"`   // 消费模式
+	ConsumeType   ConsumeType         `json:"consumeType"`   // 消费类型
 	Status        GroupStatus         `json:"status"`        // 状态
 	OnlineClients int                 `json:"onlineClients"` // 在线客户端数
 	TopicCount    int                 `json:"topicCount"`    // 订阅 Topic 数
@@ -45,22 +60,42 @@ type ConsumerGroupItem struct {
 	RetryQps      int                 `json:"retryQps"`      // 重试 QPS
 	DLQ           int                 `json:"dlq"`           // 死信数量
 	MaxRetry      int                 `json:"maxRetry"`      // 最大重试次数
+	MixedVersions bool                `json:"mixedVersions"` // 同一语言的客户端版本是否不一致
+	OutdatedCount int                 `json:"outdatedCount"` // 低于推荐版本的客户端数
 	LastUpdate    string              `json:"lastUpdate"`    // 最后更新时间
 	Remark        string              `json:"remark"`        // 备注
 	Subscriptions []GroupSubscription `json:"subscriptions"` // 订阅关系列表
 	Clients       []GroupClient       `json:"clients"`       // 客户端列表
 }
 
-// ConsumerGroupConfig 消费者组创建/更新配置
+// ConsumerGroupConfig 消费者组创建/更新配置（对应 Broker 端 SubscriptionGroupConfig）
 type ConsumerGroupConfig struct {
-	Group            string      `json:"group"`            // 消费者组名称
-	Cluster          string      `json:"cluster"`          // 集群名称
-	BrokerAddr       string      `json:"brokerAddr"`       // Broker 地址
-	ConsumeMode      ConsumeMode `json:"consumeMode"`      // 消费模式
-	MaxRetry         int         `json:"maxRetry"`         // 最大重试次数
-	ConsumeFromWhere string      `json:"consumeFromWhere"` // 消费起始位置
-	Topics           []string    `json:"topics"`           // 订阅的 Topic 列表
-	Remark           string      `json:"remark"`           // 备注
+	Group                        string            `json:"group"`                        // 消费者组名称
+	Cluster                      string            `json:"cluster"`                      // 集群名称（为空且未指定 Broker 时作用于所有集群）
+	BrokerAddr                   string            `json:"brokerAddr"`                   // Broker 地址（指定时仅作用于该 Broker）
+	Brokers                      []string          `json:"brokers"`                      // 已存在该消费者组的 Broker 名称（只读）
+	ConsumeMode                  ConsumeMode       `json:"consumeMode"`                  // 消费模式
+	ConsumeEnable                bool              `json:"consumeEnable"`                // 是否允许消费
+	ConsumeFromMinEnable         bool              `json:"consumeFromMinEnable"`         // 新队列是否从最小位点开始消费
+	ConsumeOrderly               bool              `json:"consumeOrderly"`               // 是否顺序消费（5.x）
+	MaxRetry                     int               `json:"maxRetry"`                     // 最大重试次数
+	RetryQueueNums               int               `json:"retryQueueNums"`               // 重试队列数
+	BrokerID                     int64             `json:"brokerId"`                     // 默认从哪个 Broker 消费
+	WhichBrokerWhenConsumeSlowly int64             `json:"whichBrokerWhenConsumeSlowly"` // 消费缓慢时切换到的 Broker
+	NotifyConsumerIdsChanged     bool              `json:"notifyConsumerIdsChanged"`     // 客户端变化时是否通知重平衡
+	GroupSysFlag                 int               `json:"groupSysFlag"`                 // 系统标志位
+	ConsumeTimeoutMinute         int               `json:"consumeTimeoutMinute"`         // 消费超时时间(分钟)
+	Attributes                   map[string]string `json:"attributes"`                   // 扩展属性（5.x）
+	Remark                       string            `json:"remark"`                       // 备注
+	Fields                       []string          `json:"fields"`                       // 需要写入的字段（JSON 字段名），创建时为空表示全部字段，更新时必填
+}
+
+// BrokerOperationResult 单个 Broker 上的操作结果
+type BrokerOperationResult struct {
+	BrokerName string `json:"brokerName"` // Broker 名称
+	BrokerAddr string `json:"brokerAddr"` // Broker 地址
+	Success    bool   `json:"success"`    // 是否成功
+	Error      string `json:"error"`      // 错误信息
 }
 
 // ResetOffsetRequest 重置位点请求
@@ -70,3 +105,343 @@ type ResetOffsetRequest struct {
 	Timestamp int64  `json:"timestamp"` // 时间戳(毫秒)
 	Force     bool   `json:"force"`     // 是否强制重置
 }
+
+// SubscriptionIssueType 订阅关系问题类型
+type SubscriptionIssueType string
+
+const (
+	IssueTopicMismatch          SubscriptionIssueType = "topicMismatch"          // 部分客户端未订阅该 Topic
+	IssueExpressionMismatch     SubscriptionIssueType = "expressionMismatch"     // 过滤表达式不一致
+	IssueExpressionTypeMismatch SubscriptionIssueType = "expressionTypeMismatch" // 表达式类型不一致
+	IssueTopicNotFound          SubscriptionIssueType = "topicNotFound"          // 订阅的 Topic 不存在
+	IssueClientUnreachable      SubscriptionIssueType = "clientUnreachable"      // 无法获取客户端订阅信息
+)
+
+// ClientSubscription 单个客户端的订阅关系
+type ClientSubscription struct {
+	ClientID      string              `json:"clientId"`      // 客户端ID
+	Subscriptions []GroupSubscription `json:"subscriptions"` // 订阅关系列表
+	Error         string              `json:"error"`         // 获取失败时的错误信息
+}
+
+// SubscriptionIssue 订阅关系问题
+type SubscriptionIssue struct {
+	Type      SubscriptionIssueType `json:"type"`      // 问题类型
+	Topic     string                `json:"topic"`     // 相关 Topic
+	Message   string                `json:"message"`   // 问题描述
+	ClientIDs []string              `json:"clientIds"` // 相关客户端
+}
+
+// SubscriptionCheckResult 订阅关系一致性检查结果
+type SubscriptionCheckResult struct {
+	Group      string               `json:"group"`      // 消费者组名称
+	Consistent bool                 `json:"consistent"` // 是否一致
+	Clients    []ClientSubscription `json:"clients"`    // 各客户端订阅关系
+	Issues     []SubscriptionIssue  `json:"issues"`     // 发现的问题
+	CheckedAt  string               `json:"checkedAt"`  // 检查时间
+}
+
+// ProcessQueueItem 客户端本地处理队列信息
+type ProcessQueueItem struct {
+	Topic              string `json:"topic"`              // Topic 名称
+	BrokerName         string `json:"brokerName"`         // Broker 名称
+	QueueID            int    `json:"queueId"`            // 队列ID
+	CommitOffset       int64  `json:"commitOffset"`       // 已提交位点
+	CachedMsgCount     int    `json:"cachedMsgCount"`     // 本地缓存消息数
+	CachedMsgSizeInMiB int    `json:"cachedMsgSizeInMiB"` // 本地缓存消息大小(MiB)
+	CachedMsgMinOffset int64  `json:"cachedMsgMinOffset"` // 缓存消息最小位点
+	CachedMsgMaxOffset int64  `json:"cachedMsgMaxOffset"` // 缓存消息最大位点
+	Locked             bool   `json:"locked"`             // 是否已锁定（顺序消费）
+	LastLockTime       string `json:"lastLockTime"`       // 最后加锁时间
+	Dropped            bool   `json:"dropped"`            // 是否已丢弃
+	LastPullTime       string `json:"lastPullTime"`       // 最后拉取时间
+	LastConsumeTime    string `json:"lastConsumeTime"`    // 最后消费时间
+}
+
+// TopicConsumeStatus 客户端在单个 Topic 上的消费状态
+type TopicConsumeStatus struct {
+	Topic             string  `json:"topic"`             // Topic 名称
+	PullTps           float64 `json:"pullTps"`           // 拉取 TPS
+	PullRT            float64 `json:"pullRt"`            // 拉取耗时(毫秒)
+	ConsumeOKTps      float64 `json:"consumeOkTps"`      // 消费成功 TPS
+	ConsumeFailedTps  float64 `json:"consumeFailedTps"`  // 消费失败 TPS
+	ConsumeRT         float64 `json:"consumeRt"`         // 消费耗时(毫秒)
+	ConsumeFailedMsgs int64   `json:"consumeFailedMsgs"` // 最近一小时消费失败消息数
+}
+
+// ConsumerRunningDetail 消费者客户端运行时信息
+type ConsumerRunningDetail struct {
+	Group          string               `json:"group"`          // 消费者组名称
+	ClientID       string               `json:"clientId"`       // 客户端ID
+	ConsumeType    string               `json:"consumeType"`    // 消费类型
+	MessageModel   string               `json:"messageModel"`   // 消息模式
+	ConsumeOrderly bool                 `json:"consumeOrderly"` // 是否顺序消费
+	ThreadPoolCore int                  `json:"threadPoolCore"` // 消费线程池核心线程数
+	ThreadPoolMin  int                  `json:"threadPoolMin"`  // 最小消费线程数
+	ThreadPoolMax  int                  `json:"threadPoolMax"`  // 最大消费线程数
+	ClientVersion  string               `json:"clientVersion"`  // 客户端版本
+	StartTime      string               `json:"startTime"`      // 客户端启动时间
+	Subscriptions  []GroupSubscription  `json:"subscriptions"`  // 订阅关系
+	ProcessQueues  []ProcessQueueItem   `json:"processQueues"`  // 处理队列
+	TopicStatus    []TopicConsumeStatus `json:"topicStatus"`    // 各 Topic 消费状态
+	Properties     map[string]string    `json:"properties"`     // 原始属性
+	Jstack         string               `json:"jstack"`         // 线程栈（客户端支持时返回）
+	QueriedAt      string               `json:"queriedAt"`      // 查询时间
+}
+
+// DeleteGroupRequest 全集群删除消费者组请求
+type DeleteGroupRequest struct {
+	Group           string `json:"group"`           // 消费者组名称
+	CleanRetryTopic bool   `json:"cleanRetryTopic"` // 是否删除 %RETRY% 重试 Topic
+	CleanDLQTopic   bool   `json:"cleanDlqTopic"`   // 是否删除 %DLQ% 死信 Topic
+	CleanOffsets    bool   `json:"cleanOffsets"`    // 是否同时删除 Broker 上保存的消费位点
+	Force           bool   `json:"force"`           // 有在线客户端时是否强制删除
+	Confirm         string `json:"confirm"`         // 保护模式下需输入消费者组名称确认
+}
+
+// TopicCleanupResult Topic 清理结果
+type TopicCleanupResult struct {
+	Topic   string `json:"topic"`   // Topic 名称
+	Success bool   `json:"success"` // 是否成功
+	Skipped bool   `json:"skipped"` // 是否跳过（Topic 不存在或消费者组未删除干净）
+	Error   string `json:"error"`   // 错误信息
+}
+
+// DeleteGroupResult 全集群删除消费者组结果
+type DeleteGroupResult struct {
+	Group   string                  `json:"group"`   // 消费者组名称
+	Brokers []BrokerOperationResult `json:"brokers"` // 各 Broker 删除结果
+	Topics  []TopicCleanupResult    `json:"topics"`  // 重试/死信 Topic 清理结果
+}
+
+// AllocationIssueType 队列分配问题类型
+type AllocationIssueType string
+
+const (
+	AllocationUnassignedQueue   AllocationIssueType = "unassignedQueue"   // 队列未分配给任何客户端
+	AllocationIdleClient        AllocationIssueType = "idleClient"        // 客户端未分配到队列
+	AllocationImbalance         AllocationIssueType = "imbalance"         // 客户端间队列数差异过大
+	AllocationDuplicateOwner    AllocationIssueType = "duplicateOwner"    // 同一队列被多个客户端持有
+	AllocationClientUnreachable AllocationIssueType = "clientUnreachable" // 无法获取客户端运行时信息
+)
+
+// QueueAssignment 单个队列的分配情况
+type QueueAssignment struct {
+	Topic          string   `json:"topic"`          // Topic 名称
+	BrokerName     string   `json:"brokerName"`     // Broker 名称
+	QueueID        int      `json:"queueId"`        // 队列ID
+	ClientIDs      []string `json:"clientIds"`      // 持有该队列的客户端，为空表示未分配
+	BrokerOffset   int64    `json:"brokerOffset"`   // Broker 最大位点
+	ConsumerOffset int64    `json:"consumerOffset"` // 消费位点
+	Lag            int64    `json:"lag"`            // 堆积量
+}
+
+// ClientQueueLoad 客户端在单个 Topic 上的队列负载
+type ClientQueueLoad struct {
+	ClientID   string `json:"clientId"`   // 客户端ID
+	QueueCount int    `json:"queueCount"` // 分配到的队列数
+	Lag        int64  `json:"lag"`        // 所持队列的堆积量合计
+}
+
+// TopicAllocation 单个 Topic 的队列分配视图
+type TopicAllocation struct {
+	Topic            string            `json:"topic"`            // Topic 名称
+	QueueCount       int               `json:"queueCount"`       // 队列数
+	ClientCount      int               `json:"clientCount"`      // 订阅该 Topic 的客户端数
+	UnassignedQueues int               `json:"unassignedQueues"` // 未分配队列数
+	IdleClients      []string          `json:"idleClients"`      // 未分配到队列的客户端
+	Imbalanced       bool              `json:"imbalanced"`       // 是否分配不均
+	Queues           []QueueAssignment `json:"queues"`           // 各队列分配情况
+	Clients          []ClientQueueLoad `json:"clients"`          // 各客户端负载
+}
+
+// AllocationIssue 队列分配问题
+type AllocationIssue struct {
+	Type      AllocationIssueType `json:"type"`      // 问题类型
+	Topic     string              `json:"topic"`     // 相关 Topic
+	Message   string              `json:"message"`   // 问题描述
+	ClientIDs []string            `json:"clientIds"` // 相关客户端
+}
+
+// QueueAllocationResult 消费者组队列分配结果
+type QueueAllocationResult struct {
+	Group        string            `json:"group"`        // 消费者组名称
+	MessageModel string            `json:"messageModel"` // 消息模式
+	Topics       []TopicAllocation `json:"topics"`       // 各 Topic 分配情况
+	Issues       []AllocationIssue `json:"issues"`       // 发现的问题
+	CheckedAt    string            `json:"checkedAt"`    // 检查时间
+}
+
+// StuckDetectionRequest 卡住消费检测参数
+type StuckDetectionRequest struct {
+	Group           string `json:"group"`           // 消费者组名称
+	WindowSeconds   int    `json:"windowSeconds"`   // 位点持续未推进多久视为卡住(秒)
+	IntervalSeconds int    `json:"intervalSeconds"` // 采样间隔(秒)
+}
+
+// StuckQueue 消费位点长时间未推进的队列
+type StuckQueue struct {
+	Topic          string `json:"topic"`          // Topic 名称
+	BrokerName     string `json:"brokerName"`     // Broker 名称
+	QueueID        int    `json:"queueId"`        // 队列ID
+	ClientID       string `json:"clientId"`       // 持有该队列的客户端，未知时为空
+	ConsumerOffset int64  `json:"consumerOffset"` // 消费位点
+	BrokerOffset   int64  `json:"brokerOffset"`   // Broker 最大位点
+	Lag            int64  `json:"lag"`            // 堆积量
+	StuckSince     string `json:"stuckSince"`     // 位点最后一次变化的时间
+	StuckSeconds   int64  `json:"stuckSeconds"`   // 未推进时长(秒)
+}
+
+// StuckDetectionStatus 卡住消费检测状态
+type StuckDetectionStatus struct {
+	Group           string       `json:"group"`           // 消费者组名称
+	Running         bool         `json:"running"`         // 检测是否运行中
+	WindowSeconds   int          `json:"windowSeconds"`   // 判定窗口(秒)
+	IntervalSeconds int          `json:"intervalSeconds"` // 采样间隔(秒)
+	Samples         int          `json:"samples"`         // 已采样次数
+	LastSampleAt    string       `json:"lastSampleAt"`    // 最近采样时间
+	LastError       string       `json:"lastError"`       // 最近一次采样错误
+	StuckQueues     []StuckQueue `json:"stuckQueues"`     // 当前卡住的队列
+}
+
+// StuckConsumerEvent 检测到新的卡住队列时推送给前端的事件
+type StuckConsumerEvent struct {
+	Group      string       `json:"group"`      // 消费者组名称
+	Queues     []StuckQueue `json:"queues"`     // 新发现的卡住队列
+	DetectedAt string       `json:"detectedAt"` // 检测时间
+}
+
+// HealthLevel 健康检查结论级别
+type HealthLevel string
+
+const (
+	HealthInfo     HealthLevel = "info"
+	HealthWarning  HealthLevel = "warning"
+	HealthCritical HealthLevel = "critical"
+)
+
+// GroupHealthFinding 消费者组健康检查结论
+type GroupHealthFinding struct {
+	Level       HealthLevel  `json:"level"`       // 级别
+	Type        string       `json:"type"`        // 结论类型：stuckQueue 队列消费卡住，stuckDetectionError 检测出错
+	Message     string       `json:"message"`     // 描述
+	StuckQueues []StuckQueue `json:"stuckQueues"` // 相关的卡住队列
+}
+
+// GroupHealth 消费者组健康状况
+type GroupHealth struct {
+	Group     string               `json:"group"`     // 消费者组名称
+	Status    GroupStatus          `json:"status"`    // 综合状态
+	Findings  []GroupHealthFinding `json:"findings"`  // 检查结论
+	CheckedAt string               `json:"checkedAt"` // 检查时间
+}
+
+// QueueLockState Broker 端队列锁状态
+type QueueLockState string
+
+const (
+	LockHeld    QueueLockState = "held"    // 由在线客户端持有
+	LockOrphan  QueueLockState = "orphan"  // 被非在线客户端持有（可能是崩溃的客户端）
+	LockFree    QueueLockState = "free"    // 未被锁定
+	LockPending QueueLockState = "pending" // 已分配给在线客户端，尚未加锁（不探测）
+	LockUnknown QueueLockState = "unknown" // 未探测
+)
+
+// QueueLockItem 顺序消费队列锁信息
+type QueueLockItem struct {
+	Topic          string         `json:"topic"`          // Topic 名称
+	BrokerName     string         `json:"brokerName"`     // Broker 名称
+	QueueID        int            `json:"queueId"`        // 队列ID
+	State          QueueLockState `json:"state"`          // 锁状态
+	HolderClientID string         `json:"holderClientId"` // 持有锁（或已分配）的客户端，未在线时为最近一次观察到的持有者，未知时为空
+	LastLockTime   string         `json:"lastLockTime"`   // 客户端最后续锁时间
+	ExpireTime     string         `json:"expireTime"`     // Broker 端锁预计过期时间
+	Expired        bool           `json:"expired"`        // 是否已超过锁有效期仍未续锁
+	Lag            int64          `json:"lag"`            // 堆积量
+}
+
+// QueueLockResult 消费者组队列锁检查结果
+type QueueLockResult struct {
+	Group     string          `json:"group"`     // 消费者组名称
+	Orderly   bool            `json:"orderly"`   // 消费者组是否配置为顺序消费
+	Probed    bool            `json:"probed"`    // 是否探测了 Broker 端锁状态
+	Queues    []QueueLockItem `json:"queues"`    // 各队列锁信息
+	CheckedAt string          `json:"checkedAt"` // 检查时间
+}
+
+// QueueUnlockTarget 需要强制解锁的队列
+type QueueUnlockTarget struct {
+	Topic      string `json:"topic"`      // Topic 名称
+	BrokerName string `json:"brokerName"` // Broker 名称
+	QueueID    int    `json:"queueId"`    // 队列ID
+	ClientID   string `json:"clientId"`   // 持有锁的客户端ID，为空时自动查找
+}
+
+// UnlockQueuesRequest 强制解锁队列请求
+type UnlockQueuesRequest struct {
+	Group   string              `json:"group"`   // 消费者组名称
+	Queues  []QueueUnlockTarget `json:"queues"`  // 需要解锁的队列
+	Confirm string              `json:"confirm"` // 保护模式下需输入消费者组名称确认
+}
+
+// QueueUnlockResult 单个队列解锁结果
+type QueueUnlockResult struct {
+	Topic      string `json:"topic"`      // Topic 名称
+	BrokerName string `json:"brokerName"` // Broker 名称
+	QueueID    int    `json:"queueId"`    // 队列ID
+	ClientID   string `json:"clientId"`   // 用于解锁的客户端ID
+	Success    bool   `json:"success"`    // 是否成功
+	Message    string `json:"message"`    // 结果说明
+	Error      string `json:"error"`      // 错误信息
+}
+
+// PopQueueStats Pop 消费队列统计
+type PopQueueStats struct {
+	Topic        string `json:"topic"`        // Topic 名称
+	BrokerName   string `json:"brokerName"`   // Broker 名称
+	QueueID      int    `json:"queueId"`      // 队列ID
+	BrokerOffset int64  `json:"brokerOffset"` // Broker 最大位点
+	PopOffset    int64  `json:"popOffset"`    // 已 Pop 位点
+	AckOffset    int64  `json:"ackOffset"`    // 已确认位点（消费位点）
+	Invisible    int64  `json:"invisible"`    // 已 Pop 未确认（不可见）消息数
+	Backlog      int64  `json:"backlog"`      // 未确认消息数（含未 Pop）
+}
+
+// ReviveQueueStats Broker 重投（Revive）队列进度
+type ReviveQueueStats struct {
+	BrokerName   string `json:"brokerName"`   // Broker 名称
+	QueueID      int    `json:"queueId"`      // Revive 队列ID
+	ReviveOffset int64  `json:"reviveOffset"` // 已处理的 Revive 位点
+	MaxOffset    int64  `json:"maxOffset"`    // Revive 队列最大位点
+	Pending      int64  `json:"pending"`      // 待处理的 Revive 记录数
+}
+
+// PopConsumeStats Pop 消费统计
+type PopConsumeStats struct {
+	Group          string             `json:"group"`          // 消费者组名称
+	ConsumeType    ConsumeType        `json:"consumeType"`    // 当前消费类型
+	TotalInvisible int64              `json:"totalInvisible"` // 不可见消息总数
+	TotalBacklog   int64              `json:"totalBacklog"`   // 未确认消息总数
+	Queues         []PopQueueStats    `json:"queues"`         // 各队列统计
+	Revive         []ReviveQueueStats `json:"revive"`         // Revive 队列进度
+	ReviveError    string             `json:"reviveError"`    // 获取 Revive 进度失败时的错误
+	QueriedAt      string             `json:"queriedAt"`      // 查询时间
+}
+
+// SwitchConsumeTypeRequest 切换 Pop/Push 消费请求
+type SwitchConsumeTypeRequest struct {
+	Group            string      `json:"group"`            // 消费者组名称
+	Topic            string      `json:"topic"`            // Topic 名称，为空表示全部订阅的 Topic
+	ConsumeType      ConsumeType `json:"consumeType"`      // 目标消费类型（PUSH 或 POP）
+	PopShareQueueNum int         `json:"popShareQueueNum"` // Pop 模式下每个客户端共享的队列数，<=0 表示默认值
+	Confirm          string      `json:"confirm"`          // 保护模式下需输入消费者组名称确认
+}
+
+// SwitchConsumeTypeResult 单个 Broker、Topic 上的切换结果
+type SwitchConsumeTypeResult struct {
+	Topic      string `json:"topic"`      // Topic 名称
+	BrokerName string `json:"brokerName"` // Broker 名称
+	BrokerAddr string `json:"brokerAddr"` // Broker 地址
+	Success    bool   `json:"success"`    // 是否成功
+	Error      string `json:"error"`      // 错误信息（低于 5.x 的 Broker 不支持）
+}
@@ -26,19 +26,37 @@ type MessageItem struct {
 	StoreTimestamp int64             `json:"storeTimestamp"` // 存储时间戳
 	Status         MessageStatus     `json:"status"`         // 消息状态
 	RetryTimes     int               `json:"retryTimes"`     // 重试次数
+	OriginTopic    string            `json:"originTopic"`    // 原始 Topic（重试/死信消息）
+	OriginMsgID    string            `json:"originMsgId"`    // 原始消息ID（重试/死信消息）
 	Body           string            `json:"body"`           // 消息体
+	BodyEncoding   BodyEncoding      `json:"bodyEncoding"`   // 消息体编码
+	BodySize       int               `json:"bodySize"`       // 解压后的消息体字节数
+	Compression    string            `json:"compression"`    // 消息体压缩算法（zlib/lz4/zstd），未压缩时为空
+	Compressed     bool              `json:"compressed"`     // Body 仍是压缩数据：解压失败时保留原始数据
+	Decoder        BodyDecoder       `json:"decoder"`        // Topic 记住的解码器，未配置时为空；解码结果通过 DecodeMessageBody 按需获取
 	Properties     map[string]string `json:"properties"`     // 消息属性
 }
 
+// BodyEncoding 消息体在 MessageItem.Body 中的编码方式
+type BodyEncoding string
+
+const (
+	BodyEncodingText   BodyEncoding = "text"   // UTF-8 文本
+	BodyEncodingBase64 BodyEncoding = "base64" // 非 UTF-8 的二进制内容，以 base64 表示
+)
+
 // MessageQueryParams 消息查询参数
 type MessageQueryParams struct {
 	Cluster    string `json:"cluster"`    // 集群名称
 	Topic      string `json:"topic"`      // Topic 名称
 	MessageID  string `json:"messageId"`  // 消息ID
 	MessageKey string `json:"messageKey"` // 消息Key
-	StartTime  int64  `json:"startTime"`  // 开始时间戳
-	EndTime    int64  `json:"endTime"`    // 结束时间戳
-	MaxResults int    `json:"maxResults"` // 最大返回数量
+	StartTime  int64  `json:"startTime"`  // 开始时间戳(毫秒)
+	EndTime    int64  `json:"endTime"`    // 结束时间戳(毫秒)，0 表示当前时间
+	MaxResults int    `json:"maxResults"` // 最大返回数量（按时间查询时为按存储时间从新到旧保留的消息总数）
+	PageNum    int    `json:"pageNum"`    // 页码，从 1 开始
+	PageSize   int    `json:"pageSize"`   // 每页数量
+	QueryID    string `json:"queryId"`    // 上一页返回的查询ID，翻页时传回以复用已拉取的结果
 }
 
 // ResendMessageRequest 消息重投请求
@@ -47,3 +65,572 @@ type ResendMessageRequest struct {
 	MessageID  string `json:"messageId"`  // 消息ID
 	BrokerAddr string `json:"brokerAddr"` // Broker 地址
 }
+
+// RedeliverTarget 死信消息重投目标
+type RedeliverTarget string
+
+const (
+	RedeliverToOrigin RedeliverTarget = "origin" // 投递回原始 Topic
+	RedeliverToRetry  RedeliverTarget = "retry"  // 投递到消费者组重试 Topic
+)
+
+// DLQQueryParams 死信消息查询参数
+type DLQQueryParams struct {
+	Group      string `json:"group"`      // 消费者组名称
+	StartTime  int64  `json:"startTime"`  // 开始时间戳(毫秒)，0 表示不限
+	EndTime    int64  `json:"endTime"`    // 结束时间戳(毫秒)，0 表示不限
+	Key        string `json:"key"`        // 按消息 Key 或消息ID过滤
+	MaxResults int    `json:"maxResults"` // 最大返回数量
+	ScanLimit  int    `json:"scanLimit"`  // 最多扫描的消息数，<=0 表示默认值
+}
+
+// DLQQueryResult 死信消息查询结果
+type DLQQueryResult struct {
+	Group     string         `json:"group"`     // 消费者组名称
+	Topic     string         `json:"topic"`     // 死信 Topic
+	Messages  []*MessageItem `json:"messages"`  // 消息列表
+	Scanned   int64          `json:"scanned"`   // 已扫描消息数
+	Truncated bool           `json:"truncated"` // 是否因达到上限被截断
+}
+
+// DLQRedeliverRequest 死信消息重投请求
+type DLQRedeliverRequest struct {
+	Group         string          `json:"group"`         // 消费者组名称
+	MessageIDs    []string        `json:"messageIds"`    // 选中的消息ID，为空时按过滤条件重投
+	Filter        DLQQueryParams  `json:"filter"`        // 过滤条件（MessageIDs 为空时生效）
+	Target        RedeliverTarget `json:"target"`        // 重投目标
+	RatePerSecond int             `json:"ratePerSecond"` // 每秒最多发送条数，<=0 表示默认值，最大 1000
+	Force         bool            `json:"force"`         // 是否忽略重投记录再次投递
+}
+
+// DLQRedeliverItem 单条死信消息重投结果
+type DLQRedeliverItem struct {
+	MessageID    string `json:"messageId"`    // 死信消息ID
+	OriginTopic  string `json:"originTopic"`  // 原始 Topic
+	TargetTopic  string `json:"targetTopic"`  // 投递目标 Topic
+	NewMessageID string `json:"newMessageId"` // 新消息ID
+	Success      bool   `json:"success"`      // 是否成功
+	Skipped      bool   `json:"skipped"`      // 是否因已重投而跳过
+	Error        string `json:"error"`        // 错误信息
+}
+
+// DLQRedeliverResult 死信消息重投结果
+type DLQRedeliverResult struct {
+	Group     string             `json:"group"`     // 消费者组名称
+	Total     int                `json:"total"`     // 处理消息数
+	Succeeded int                `json:"succeeded"` // 成功数
+	Skipped   int                `json:"skipped"`   // 跳过数
+	Failed    int                `json:"failed"`    // 失败数
+	Items     []DLQRedeliverItem `json:"items"`     // 逐条结果
+}
+
+// RedeliveryRecord 死信消息重投记录（用于幂等）
+type RedeliveryRecord struct {
+	MessageID    string          `json:"messageId"`    // 死信消息ID
+	TargetTopic  string          `json:"targetTopic"`  // 投递目标 Topic
+	Target       RedeliverTarget `json:"target"`       // 重投目标
+	NewMessageID string          `json:"newMessageId"` // 新消息ID
+	DeliveredAt  string          `json:"deliveredAt"`  // 投递时间
+}
+
+// RetryQueryParams 重试消息查询参数
+type RetryQueryParams struct {
+	Group         string `json:"group"`         // 消费者组名称
+	StartTime     int64  `json:"startTime"`     // 开始时间戳(毫秒)，0 表示不限
+	EndTime       int64  `json:"endTime"`       // 结束时间戳(毫秒)，0 表示不限
+	Key           string `json:"key"`           // 按消息 Key、消息ID或原始消息ID过滤
+	MaxResults    int    `json:"maxResults"`    // 最大返回消息数（统计不受此限制）
+	BucketMinutes int    `json:"bucketMinutes"` // 统计时间桶宽度(分钟)，<=0 表示默认值
+}
+
+// RetryMessageItem 重试消息
+type RetryMessageItem struct {
+	Message        *MessageItem `json:"message"`        // 消息信息
+	NextDelayLevel int          `json:"nextDelayLevel"` // 再次消费失败时的延迟级别
+	NextRetryDelay string       `json:"nextRetryDelay"` // 再次消费失败时的延迟时间
+	WillDeadLetter bool         `json:"willDeadLetter"` // 再次消费失败是否进入死信队列
+}
+
+// RetryRateBucket 重试消息时间桶统计
+type RetryRateBucket struct {
+	StartTime  int64   `json:"startTime"`  // 桶开始时间戳(毫秒)
+	Count      int     `json:"count"`      // 重试消息数
+	Distinct   int     `json:"distinct"`   // 涉及的原始消息数
+	PerMinute  float64 `json:"perMinute"`  // 平均每分钟重试消息数
+	MaxRetries int     `json:"maxRetries"` // 桶内最大重试次数
+}
+
+// PoisonMessageCandidate 疑似毒消息（反复消费失败的原始消息）
+type PoisonMessageCandidate struct {
+	OriginMsgID       string `json:"originMsgId"`       // 原始消息ID
+	OriginTopic       string `json:"originTopic"`       // 原始 Topic
+	Keys              string `json:"keys"`              // 消息Keys
+	Occurrences       int    `json:"occurrences"`       // 在重试 Topic 中出现的次数
+	MaxReconsumeTimes int    `json:"maxReconsumeTimes"` // 最大重试次数
+	FirstSeen         string `json:"firstSeen"`         // 首次出现时间
+	LastSeen          string `json:"lastSeen"`          // 最近出现时间
+}
+
+// RetryQueryResult 重试消息查询结果
+type RetryQueryResult struct {
+	Group            string                   `json:"group"`            // 消费者组名称
+	Topic            string                   `json:"topic"`            // 重试 Topic
+	MaxRetryTimes    int                      `json:"maxRetryTimes"`    // 消费者组最大重试次数
+	Messages         []RetryMessageItem       `json:"messages"`         // 重试消息
+	Matched          int                      `json:"matched"`          // 符合条件的消息数
+	Buckets          []RetryRateBucket        `json:"buckets"`          // 重试率时间分布
+	PoisonCandidates []PoisonMessageCandidate `json:"poisonCandidates"` // 疑似毒消息
+	Scanned          int64                    `json:"scanned"`          // 已扫描消息数
+	Truncated        bool                     `json:"truncated"`        // 是否因达到扫描上限被截断
+}
+
+// MessagePage 分页消息结果
+type MessagePage struct {
+	Topic     string         `json:"topic"`     // Topic 名称
+	Messages  []*MessageItem `json:"messages"`  // 当前页消息
+	Total     int            `json:"total"`     // 可翻页的消息总数（不超过上限）
+	InRange   int64          `json:"inRange"`   // 时间范围内的消息估算总数
+	PageNum   int            `json:"pageNum"`   // 当前页码
+	PageSize  int            `json:"pageSize"`  // 每页数量
+	Truncated bool           `json:"truncated"` // 是否因达到上限只保留了最新的消息
+	QueryID   string         `json:"queryId"`   // 查询ID，翻页时传回
+}
+
+// QueueBrowseParams 按队列位点浏览消息参数
+type QueueBrowseParams struct {
+	Topic      string `json:"topic"`      // Topic 名称
+	BrokerName string `json:"brokerName"` // Broker 名称
+	QueueID    int    `json:"queueId"`    // 队列ID
+	Offset     int64  `json:"offset"`     // 起始位点，<0 表示从队列末尾向前取一页
+	Count      int    `json:"count"`      // 每页数量
+}
+
+// QueueBrowseResult 按队列位点浏览消息结果
+type QueueBrowseResult struct {
+	Topic       string         `json:"topic"`       // Topic 名称
+	BrokerName  string         `json:"brokerName"`  // Broker 名称
+	QueueID     int            `json:"queueId"`     // 队列ID
+	MinOffset   int64          `json:"minOffset"`   // 队列最小位点
+	MaxOffset   int64          `json:"maxOffset"`   // 队列最大位点
+	StartOffset int64          `json:"startOffset"` // 本页起始位点
+	NextOffset  int64          `json:"nextOffset"`  // 下一页起始位点
+	PrevOffset  int64          `json:"prevOffset"`  // 上一页起始位点
+	HasNext     bool           `json:"hasNext"`     // 是否有下一页
+	HasPrev     bool           `json:"hasPrev"`     // 是否有上一页
+	Messages    []*MessageItem `json:"messages"`    // 消息列表
+}
+
+// SendMessageRequest 发送消息请求
+type SendMessageRequest struct {
+	Topic       string            `json:"topic"`       // Topic 名称
+	Tags        string            `json:"tags"`        // 消息标签
+	Keys        []string          `json:"keys"`        // 消息Keys
+	Body        string            `json:"body"`        // 消息体
+	Properties  map[string]string `json:"properties"`  // 自定义属性
+	DelayLevel  int               `json:"delayLevel"`  // 延迟级别(1-18)，0 表示不延迟
+	DeliverTime int64             `json:"deliverTime"` // 定时投递时间戳(毫秒)，0 表示不定时（需 5.x Broker）
+	ShardingKey string            `json:"shardingKey"` // 分区顺序键，相同键的消息发送到同一队列
+	BrokerName  string            `json:"brokerName"`  // 指定发送的 Broker，为空时自动选择队列
+	QueueID     int               `json:"queueId"`     // 指定发送的队列ID（BrokerName 不为空时生效）
+}
+
+// SendMessageResult 发送消息结果
+type SendMessageResult struct {
+	Status      string `json:"status"`      // 发送状态
+	MessageID   string `json:"messageId"`   // 消息ID（UNIQ_KEY）
+	OffsetMsgID string `json:"offsetMsgId"` // 偏移消息ID
+	Topic       string `json:"topic"`       // Topic 名称
+	BrokerName  string `json:"brokerName"`  // 写入的 Broker
+	QueueID     int    `json:"queueId"`     // 写入的队列ID
+	QueueOffset int64  `json:"queueOffset"` // 队列偏移
+}
+
+// TraceType 消息轨迹事件类型
+type TraceType string
+
+const (
+	TracePub            TraceType = "Pub"            // 生产者发送
+	TraceSubBefore      TraceType = "SubBefore"      // 消费者开始消费
+	TraceSubAfter       TraceType = "SubAfter"       // 消费者消费完成
+	TraceEndTransaction TraceType = "EndTransaction" // 事务消息提交/回滚
+)
+
+// TraceRole 轨迹时间线所属角色
+type TraceRole string
+
+const (
+	TraceRoleProducer TraceRole = "producer"
+	TraceRoleConsumer TraceRole = "consumer"
+)
+
+// MessageTraceQuery 消息轨迹查询参数
+type MessageTraceQuery struct {
+	MsgID      string `json:"msgId"`      // 消息ID，与 Key 二选一
+	Key        string `json:"key"`        // 消息Key
+	TraceTopic string `json:"traceTopic"` // 轨迹 Topic，为空时使用 RMQ_SYS_TRACE_TOPIC
+	MaxResults int    `json:"maxResults"` // 最多读取的轨迹消息数
+}
+
+// TraceEvent 消息轨迹事件
+type TraceEvent struct {
+	Type             TraceType `json:"type"`             // 事件类型
+	Timestamp        int64     `json:"timestamp"`        // 事件时间戳(毫秒)
+	Time             string    `json:"time"`             // 事件时间
+	Group            string    `json:"group"`            // 生产者组或消费者组
+	Topic            string    `json:"topic"`            // Topic 名称
+	MsgID            string    `json:"msgId"`            // 消息ID
+	OffsetMsgID      string    `json:"offsetMsgId"`      // 偏移消息ID
+	Tags             string    `json:"tags"`             // 消息标签
+	Keys             string    `json:"keys"`             // 消息Keys
+	StoreHost        string    `json:"storeHost"`        // 存储节点
+	ClientHost       string    `json:"clientHost"`       // 上报轨迹的客户端地址
+	BodyLength       int       `json:"bodyLength"`       // 消息体长度
+	CostTime         int64     `json:"costTime"`         // 耗时(毫秒)
+	Success          bool      `json:"success"`          // 是否成功
+	MsgType          string    `json:"msgType"`          // 消息类型
+	RequestID        string    `json:"requestId"`        // 消费请求ID（关联 SubBefore/SubAfter）
+	RetryTimes       int       `json:"retryTimes"`       // 重试次数
+	ContextCode      int       `json:"contextCode"`      // 消费结果码
+	TransactionID    string    `json:"transactionId"`    // 事务ID
+	TransactionState string    `json:"transactionState"` // 事务状态
+	FromCheck        bool      `json:"fromCheck"`        // 是否来自事务回查
+}
+
+// TraceGroupTimeline 单个生产者组或消费者组的轨迹时间线
+type TraceGroupTimeline struct {
+	Group       string       `json:"group"`       // 组名称
+	Role        TraceRole    `json:"role"`        // 角色
+	Status      string       `json:"status"`      // 最终状态：success/failed/consuming
+	TotalCost   int64        `json:"totalCost"`   // 累计耗时(毫秒)
+	Attempts    int          `json:"attempts"`    // 发送或消费次数
+	ClientHosts []string     `json:"clientHosts"` // 涉及的客户端地址
+	Events      []TraceEvent `json:"events"`      // 按时间排序的事件
+}
+
+// MessageTrace 单条消息的轨迹
+type MessageTrace struct {
+	MsgID     string               `json:"msgId"`     // 消息ID
+	Topic     string               `json:"topic"`     // Topic 名称
+	Tags      string               `json:"tags"`      // 消息标签
+	Keys      string               `json:"keys"`      // 消息Keys
+	StoreHost string               `json:"storeHost"` // 存储节点
+	Groups    []TraceGroupTimeline `json:"groups"`    // 各组时间线，生产者在前
+}
+
+// MessageTraceResult 消息轨迹查询结果
+type MessageTraceResult struct {
+	TraceTopic string         `json:"traceTopic"` // 轨迹 Topic
+	Traces     []MessageTrace `json:"traces"`     // 各消息轨迹
+}
+
+// BodyDecoder 消息体解码器
+type BodyDecoder string
+
+const (
+	DecoderAuto     BodyDecoder = "auto"     // 自动识别 JSON/XML/文本，二进制时输出十六进制
+	DecoderText     BodyDecoder = "text"     // UTF-8 文本
+	DecoderJSON     BodyDecoder = "json"     // 格式化 JSON
+	DecoderXML      BodyDecoder = "xml"      // 格式化 XML
+	DecoderHex      BodyDecoder = "hex"      // 十六进制转储
+	DecoderBase64   BodyDecoder = "base64"   // base64
+	DecoderProtobuf BodyDecoder = "protobuf" // Protobuf，需提供描述符集合与消息类型
+	DecoderAvro     BodyDecoder = "avro"     // Avro，需提供 Schema
+)
+
+// DecoderConfig 消息体解码配置
+type DecoderConfig struct {
+	Topic            string      `json:"topic"`            // Topic 名称
+	Decoder          BodyDecoder `json:"decoder"`          // 解码器
+	ProtoDescriptor  string      `json:"protoDescriptor"`  // Protobuf 描述符集合文件路径（protoc --descriptor_set_out --include_imports）
+	ProtoMessageType string      `json:"protoMessageType"` // Protobuf 消息全名，如 com.example.OrderEvent
+	AvroSchema       string      `json:"avroSchema"`       // Avro Schema（JSON）
+	UpdatedAt        string      `json:"updatedAt"`        // 更新时间
+}
+
+// DecodedBody 消息体解码结果
+type DecodedBody struct {
+	Decoder     BodyDecoder `json:"decoder"`     // 实际使用的解码器
+	Compression string      `json:"compression"` // 消息体压缩算法（zlib/lz4/zstd），未压缩时为空
+	Compressed  bool        `json:"compressed"`  // 解码的仍是压缩数据：解压失败时保留原始数据
+	Content     string      `json:"content"`     // 解码后的内容
+	Size        int         `json:"size"`        // 解压后的消息体字节数
+	RawSize     int         `json:"rawSize"`     // 原始消息体字节数
+	Error       string      `json:"error"`       // 指定解码器失败时的错误信息（此时 Content 为自动识别结果）
+}
+
+// DecodeBodyRequest 消息体解码请求
+type DecodeBodyRequest struct {
+	Topic  string         `json:"topic"`  // Topic 名称
+	MsgID  string         `json:"msgId"`  // 消息ID
+	Config *DecoderConfig `json:"config"` // 解码配置，为 nil 时使用 Topic 记住的解码器
+}
+
+// ExportFormat 消息导出文件格式
+type ExportFormat string
+
+const (
+	ExportJSONL ExportFormat = "jsonl" // 每行一条 JSON
+	ExportCSV   ExportFormat = "csv"   // CSV，属性列为 JSON 字符串
+)
+
+// ExportedMessage 导出文件中的单条消息
+type ExportedMessage struct {
+	Topic          string            `json:"topic"`          // Topic 名称
+	MessageID      string            `json:"messageId"`      // 消息ID
+	Tags           string            `json:"tags"`           // 消息标签
+	Keys           string            `json:"keys"`           // 消息Keys
+	QueueID        int               `json:"queueId"`        // 队列ID
+	QueueOffset    int64             `json:"queueOffset"`    // 队列偏移
+	StoreHost      string            `json:"storeHost"`      // 存储节点
+	BornHost       string            `json:"bornHost"`       // 生产节点
+	StoreTimestamp int64             `json:"storeTimestamp"` // 存储时间戳
+	RetryTimes     int               `json:"retryTimes"`     // 重试次数
+	BodyEncoding   BodyEncoding      `json:"bodyEncoding"`   // 消息体编码
+	Compression    string            `json:"compression"`    // 消息体原始压缩算法（zlib/lz4/zstd），未压缩时为空
+	Compressed     bool              `json:"compressed"`     // Body 仍是未能解压的压缩数据，不能回放
+	Body           string            `json:"body"`           // 消息体（二进制为 base64）
+	Properties     map[string]string `json:"properties"`     // 消息属性
+}
+
+// MessageExportRequest 消息导出请求，TimeQuery、Browse、Messages 三选一
+type MessageExportRequest struct {
+	Format    ExportFormat        `json:"format"`    // 导出格式
+	FilePath  string              `json:"filePath"`  // 导出文件路径，为空时写入应用数据目录
+	TimeQuery *MessageQueryParams `json:"timeQuery"` // 按时间范围查询的全部结果（不分页）
+	Browse    *QueueBrowseParams  `json:"browse"`    // 按队列位点浏览的一页结果
+	Messages  []*MessageItem      `json:"messages"`  // 前端已有的消息列表
+}
+
+// MessageExportResult 消息导出结果
+type MessageExportResult struct {
+	FilePath        string       `json:"filePath"`        // 导出文件路径
+	Format          ExportFormat `json:"format"`          // 导出格式
+	Count           int          `json:"count"`           // 导出消息数
+	BinaryCount     int          `json:"binaryCount"`     // 以 base64 导出的二进制消息数
+	CompressedCount int          `json:"compressedCount"` // 消息体未能解压、仍为压缩数据的消息数，这些消息不能回放
+	Truncated       bool         `json:"truncated"`       // 时间查询结果超过上限，只导出了最新的部分消息
+}
+
+// MessageReplayRequest 消息回放请求
+type MessageReplayRequest struct {
+	FilePath         string            `json:"filePath"`         // 导出文件路径（.csv 按 CSV 解析，其余按 JSONL）
+	NameServer       string            `json:"nameServer"`       // 目标连接的 NameServer，为空时使用默认连接（需已连接）
+	TargetTopic      string            `json:"targetTopic"`      // 目标 Topic
+	Tags             string            `json:"tags"`             // 替换消息标签，为空时保留原标签
+	Keys             []string          `json:"keys"`             // 替换消息Keys，为空时保留原Keys
+	Properties       map[string]string `json:"properties"`       // 新增或覆盖的属性
+	RemoveProperties []string          `json:"removeProperties"` // 删除的属性
+	RatePerSecond    int               `json:"ratePerSecond"`    // 每秒最多发送条数，<=0 表示默认值
+	Limit            int               `json:"limit"`            // 最多回放条数，<=0 表示全部
+	DryRun           bool              `json:"dryRun"`           // 只解析和构造消息，不发送
+	Confirm          string            `json:"confirm"`          // 目标连接处于保护模式时需输入目标 Topic 确认
+}
+
+// MessageReplayItem 单条消息回放结果
+type MessageReplayItem struct {
+	Line         int               `json:"line"`         // 文件中的序号（从 1 开始）
+	SourceMsgID  string            `json:"sourceMsgId"`  // 原消息ID
+	TargetTopic  string            `json:"targetTopic"`  // 目标 Topic
+	Tags         string            `json:"tags"`         // 发送的消息标签
+	Keys         string            `json:"keys"`         // 发送的消息Keys
+	Properties   map[string]string `json:"properties"`   // 发送的用户属性
+	BodySize     int               `json:"bodySize"`     // 消息体字节数
+	NewMessageID string            `json:"newMessageId"` // 新消息ID
+	Success      bool              `json:"success"`      // 是否成功（试运行时表示构造成功）
+	Error        string            `json:"error"`        // 错误信息
+	SentAt       string            `json:"sentAt"`       // 处理时间
+}
+
+// MessageReplayProgress 消息回放进度
+type MessageReplayProgress struct {
+	ReplayID      string `json:"replayId"`      // 回放ID
+	FilePath      string `json:"filePath"`      // 回放文件路径
+	NameServer    string `json:"nameServer"`    // 目标连接的 NameServer
+	TargetTopic   string `json:"targetTopic"`   // 目标 Topic
+	DryRun        bool   `json:"dryRun"`        // 是否试运行
+	Total         int    `json:"total"`         // 待回放消息数
+	Processed     int    `json:"processed"`     // 已处理消息数
+	Succeeded     int    `json:"succeeded"`     // 成功数
+	Failed        int    `json:"failed"`        // 失败数
+	Running       bool   `json:"running"`       // 是否运行中
+	Cancelled     bool   `json:"cancelled"`     // 是否被取消
+	Error         string `json:"error"`         // 中止回放的错误信息（单条失败记录在明细中）
+	LogPath       string `json:"logPath"`       // 逐条结果日志文件（JSONL）
+	StartedAt     string `json:"startedAt"`     // 开始时间
+	ElapsedMillis int64  `json:"elapsedMillis"` // 已耗时(毫秒)
+}
+
+// MessageReplayItemsEvent 消息回放推送的一批逐条结果
+type MessageReplayItemsEvent struct {
+	ReplayID string              `json:"replayId"` // 回放ID
+	Items    []MessageReplayItem `json:"items"`    // 逐条结果
+}
+
+// FilterExpressionType 订阅过滤表达式类型
+type FilterExpressionType string
+
+const (
+	FilterTag   FilterExpressionType = "TAG"   // Tag 表达式，如 TagA || TagB
+	FilterSQL92 FilterExpressionType = "SQL92" // SQL92 属性过滤表达式
+)
+
+// MessageFilterRequest 消息过滤预览请求
+// BrokerName 不为空时按单个队列的位点区间扫描，否则按时间范围扫描 Topic 全部队列。
+type MessageFilterRequest struct {
+	Topic          string               `json:"topic"`          // Topic 名称
+	ExpressionType FilterExpressionType `json:"expressionType"` // 表达式类型
+	Expression     string               `json:"expression"`     // 过滤表达式
+	StartTime      int64                `json:"startTime"`      // 开始时间戳(毫秒)，0 表示不限
+	EndTime        int64                `json:"endTime"`        // 结束时间戳(毫秒)，0 表示不限
+	BrokerName     string               `json:"brokerName"`     // 按位点扫描的 Broker 名称
+	QueueID        int                  `json:"queueId"`        // 按位点扫描的队列ID
+	StartOffset    int64                `json:"startOffset"`    // 起始位点，<0 表示队列最小位点
+	EndOffset      int64                `json:"endOffset"`      // 结束位点（不含），<=0 表示队列最大位点
+	MaxResults     int                  `json:"maxResults"`     // 命中与未命中消息各最多返回条数
+	ScanLimit      int                  `json:"scanLimit"`      // 最多扫描的消息数
+}
+
+// FilteredMessage 单条消息的过滤结果
+type FilteredMessage struct {
+	Message *MessageItem `json:"message"` // 消息信息
+	Matched bool         `json:"matched"` // 是否会投递给订阅者
+	Result  string       `json:"result"`  // 表达式结果：TRUE/FALSE/UNKNOWN
+	Reason  string       `json:"reason"`  // 未命中原因
+}
+
+// FilterTagStat 按 Tag 统计的命中情况
+type FilterTagStat struct {
+	Tag     string `json:"tag"`     // 消息标签，空字符串表示无标签
+	Total   int    `json:"total"`   // 消息数
+	Matched int    `json:"matched"` // 命中数
+}
+
+// FilterPropertyStat SQL92 表达式引用属性的出现情况
+type FilterPropertyStat struct {
+	Property string `json:"property"` // 属性名
+	Present  int    `json:"present"`  // 存在该属性的消息数
+	Missing  int    `json:"missing"`  // 缺少该属性的消息数
+}
+
+// MessageFilterResult 消息过滤预览结果
+type MessageFilterResult struct {
+	Topic          string               `json:"topic"`          // Topic 名称
+	ExpressionType FilterExpressionType `json:"expressionType"` // 表达式类型
+	Expression     string               `json:"expression"`     // 过滤表达式
+	Tags           []string             `json:"tags"`           // 解析出的订阅标签，为空表示订阅全部
+	Properties     []string             `json:"properties"`     // SQL92 表达式引用的属性
+	Scanned        int                  `json:"scanned"`        // 已扫描消息数
+	Matched        int                  `json:"matched"`        // 命中数
+	Unmatched      int                  `json:"unmatched"`      // 未命中数（含 UNKNOWN）
+	Unknown        int                  `json:"unknown"`        // 表达式结果为 UNKNOWN 的消息数
+	MatchRate      float64              `json:"matchRate"`      // 命中率(%)
+	Matches        []FilteredMessage    `json:"matches"`        // 命中的消息
+	Mismatches     []FilteredMessage    `json:"mismatches"`     // 未命中的消息及原因
+	TagStats       []FilterTagStat      `json:"tagStats"`       // 按 Tag 统计
+	PropertyStats  []FilterPropertyStat `json:"propertyStats"`  // 引用属性的出现情况
+	Truncated      bool                 `json:"truncated"`      // 是否因达到扫描上限被截断
+}
+
+// MessageIDType 消息ID类型
+type MessageIDType string
+
+const (
+	MessageIDOffset  MessageIDType = "offset"  // 偏移消息ID（Broker 生成，编码存储节点与 CommitLog 偏移）
+	MessageIDUnique  MessageIDType = "unique"  // 唯一键 UNIQ_KEY（4.x 客户端生成，编码 IP、PID、时间与计数器）
+	MessageIDV5      MessageIDType = "v5"      // 5.x 客户端消息ID（编码 MAC、PID、时间与序号）
+	MessageIDUnknown MessageIDType = "unknown" // 无法识别
+)
+
+// MessageIDInfo 消息ID解析结果
+type MessageIDInfo struct {
+	Input           string        `json:"input"`           // 输入的消息ID
+	Type            MessageIDType `json:"type"`            // 消息ID类型
+	StoreHost       string        `json:"storeHost"`       // 存储节点（偏移消息ID）
+	CommitLogOffset int64         `json:"commitLogOffset"` // CommitLog 物理偏移（偏移消息ID）
+	ClientIP        string        `json:"clientIp"`        // 生产者 IP（唯一键）
+	MAC             string        `json:"mac"`             // 生产者 MAC 地址（5.x 消息ID）
+	PID             int           `json:"pid"`             // 生产者进程号（低 16 位）
+	ClassLoaderHash string        `json:"classLoaderHash"` // 生产者 ClassLoader 哈希（唯一键）
+	Timestamp       int64         `json:"timestamp"`       // 推算的生产时间戳(毫秒)
+	Time            string        `json:"time"`            // 推算的生产时间
+	Counter         int64         `json:"counter"`         // 生产者内的自增计数器/序号
+	Notes           []string      `json:"notes"`           // 解析说明
+}
+
+// TailRequest 实时跟踪 Topic 新消息的请求
+type TailRequest struct {
+	Topic              string `json:"topic"`              // Topic 名称
+	Tags               string `json:"tags"`               // Tag 过滤表达式，如 TagA || TagB，为空或 * 表示全部
+	MaxRatePerSecond   int    `json:"maxRatePerSecond"`   // 每秒最多拉取的消息数，超出部分跳过，<=0 表示默认值
+	IntervalMillis     int    `json:"intervalMillis"`     // 轮询间隔(毫秒)，<=0 表示默认值
+	MaxDurationSeconds int    `json:"maxDurationSeconds"` // 最长跟踪时间(秒)，到期自动停止，<=0 表示默认值
+}
+
+// TailStatus 实时跟踪状态
+type TailStatus struct {
+	TailID     string `json:"tailId"`     // 跟踪ID
+	Topic      string `json:"topic"`      // Topic 名称
+	Tags       string `json:"tags"`       // Tag 过滤表达式
+	Running    bool   `json:"running"`    // 是否运行中
+	StartedAt  string `json:"startedAt"`  // 开始时间
+	Received   int64  `json:"received"`   // 已拉取的消息数
+	Emitted    int64  `json:"emitted"`    // 已推送的消息数
+	Filtered   int64  `json:"filtered"`   // 被 Tag 过滤的消息数
+	Dropped    int64  `json:"dropped"`    // 因超出速率上限被跳过的消息数
+	LastError  string `json:"lastError"`  // 最近一次错误
+	StopReason string `json:"stopReason"` // 停止原因
+}
+
+// TailMessagesEvent 实时跟踪推送的一批新消息
+type TailMessagesEvent struct {
+	TailID    string         `json:"tailId"`    // 跟踪ID
+	Topic     string         `json:"topic"`     // Topic 名称
+	Messages  []*MessageItem `json:"messages"`  // 新消息，按存储时间排序
+	Dropped   int64          `json:"dropped"`   // 本轮因超出速率上限被跳过的消息数
+	EmittedAt string         `json:"emittedAt"` // 推送时间
+}
+
+// BodySearchMode 消息体搜索方式
+type BodySearchMode string
+
+const (
+	SearchSubstring BodySearchMode = "substring" // 子串匹配
+	SearchRegex     BodySearchMode = "regex"     // 正则表达式
+	SearchJSONPath  BodySearchMode = "jsonpath"  // JSONPath 取值匹配
+)
+
+// BodySearchRequest 消息体全文搜索请求
+type BodySearchRequest struct {
+	Topic         string         `json:"topic"`         // Topic 名称
+	StartTime     int64          `json:"startTime"`     // 开始时间戳(毫秒)
+	EndTime       int64          `json:"endTime"`       // 结束时间戳(毫秒)，0 表示当前时间
+	Mode          BodySearchMode `json:"mode"`          // 搜索方式
+	Pattern       string         `json:"pattern"`       // 子串、正则表达式或 JSONPath（如 $.order.id、$.items[*].sku）
+	JSONValue     string         `json:"jsonValue"`     // JSONPath 期望值，为空时只要求路径存在
+	CaseSensitive bool           `json:"caseSensitive"` // 是否区分大小写
+	MaxMatches    int            `json:"maxMatches"`    // 最多命中条数，达到后停止
+	ScanLimit     int            `json:"scanLimit"`     // 最多扫描的消息数（硬上限）
+	Concurrency   int            `json:"concurrency"`   // 并行拉取的队列数
+}
+
+// BodySearchProgress 消息体搜索进度
+type BodySearchProgress struct {
+	SearchID      string `json:"searchId"`      // 搜索ID
+	Topic         string `json:"topic"`         // Topic 名称
+	Scanned       int64  `json:"scanned"`       // 已扫描消息数
+	Matched       int64  `json:"matched"`       // 已命中消息数
+	QueuesDone    int    `json:"queuesDone"`    // 已完成的队列数
+	QueuesTotal   int    `json:"queuesTotal"`   // 队列总数
+	Running       bool   `json:"running"`       // 是否运行中
+	Truncated     bool   `json:"truncated"`     // 是否因达到扫描上限或命中上限提前结束
+	Cancelled     bool   `json:"cancelled"`     // 是否被取消
+	Error         string `json:"error"`         // 错误信息
+	StartedAt     string `json:"startedAt"`     // 开始时间
+	ElapsedMillis int64  `json:"elapsedMillis"` // 已耗时(毫秒)
+}
+
+// BodySearchMatchEvent 消息体搜索推送的一批命中消息
+type BodySearchMatchEvent struct {
+	SearchID string         `json:"searchId"` // 搜索ID
+	Messages []*MessageItem `json:"messages"` // 命中的消息
+}
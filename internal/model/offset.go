@@ -0,0 +1,127 @@
+package model
+
+// QueueOffset 单个队列的消费位点
+type QueueOffset struct {
+	Group          string `json:"group"`          // 消费者组名称
+	Topic          string `json:"topic"`          // Topic 名称
+	BrokerName     string `json:"brokerName"`     // Broker 名称
+	QueueID        int    `json:"queueId"`        // 队列ID
+	BrokerOffset   int64  `json:"brokerOffset"`   // Broker 最大位点
+	ConsumerOffset int64  `json:"consumerOffset"` // 消费位点
+	LastTimestamp  int64  `json:"lastTimestamp"`  // 最后消费消息的存储时间戳(毫秒)
+}
+
+// OffsetSnapshotSource 消费位点快照来源
+type OffsetSnapshotSource string
+
+const (
+	SnapshotManual      OffsetSnapshotSource = "manual"      // 手动创建
+	SnapshotSkipBacklog OffsetSnapshotSource = "skipBacklog" // 跳过堆积前自动创建，可撤销
+)
+
+// OffsetSnapshot 消费位点快照
+type OffsetSnapshot struct {
+	Version    int                  `json:"version"`    // 快照文件格式版本
+	ID         string               `json:"id"`         // 快照ID
+	Group      string               `json:"group"`      // 消费者组名称
+	NameServer string               `json:"nameServer"` // 采集时的 NameServer 地址
+	CreatedAt  string               `json:"createdAt"`  // 创建时间
+	Timestamp  int64                `json:"timestamp"`  // 创建时间戳(毫秒)
+	Source     OffsetSnapshotSource `json:"source"`     // 快照来源，旧版本快照文件缺省时视为手动创建
+	Remark     string               `json:"remark"`     // 备注
+	Offsets    []QueueOffset        `json:"offsets"`    // 各队列位点
+}
+
+// OffsetSnapshotInfo 消费位点快照摘要（用于列表展示）
+type OffsetSnapshotInfo struct {
+	ID         string               `json:"id"`         // 快照ID
+	Group      string               `json:"group"`      // 消费者组名称
+	NameServer string               `json:"nameServer"` // 采集时的 NameServer 地址
+	CreatedAt  string               `json:"createdAt"`  // 创建时间
+	Timestamp  int64                `json:"timestamp"`  // 创建时间戳(毫秒)
+	Source     OffsetSnapshotSource `json:"source"`     // 快照来源
+	Remark     string               `json:"remark"`     // 备注
+	Topics     []string             `json:"topics"`     // 包含的 Topic 列表
+	QueueCount int                  `json:"queueCount"` // 队列数量
+}
+
+// OffsetDiffItem 位点差异条目
+type OffsetDiffItem struct {
+	Topic      string `json:"topic"`      // Topic 名称
+	BrokerName string `json:"brokerName"` // Broker 名称
+	QueueID    int    `json:"queueId"`    // 队列ID
+	BaseOffset int64  `json:"baseOffset"` // 基准位点（-1 表示不存在）
+	NewOffset  int64  `json:"newOffset"`  // 对比位点（-1 表示不存在）
+	Delta      int64  `json:"delta"`      // 位点差值
+}
+
+// OffsetUpdateResult 单个队列的位点写入结果
+type OffsetUpdateResult struct {
+	Topic      string `json:"topic"`      // Topic 名称
+	BrokerName string `json:"brokerName"` // Broker 名称
+	BrokerAddr string `json:"brokerAddr"` // Broker 地址
+	QueueID    int    `json:"queueId"`    // 队列ID
+	Offset     int64  `json:"offset"`     // 写入的位点
+	Success    bool   `json:"success"`    // 是否成功
+	Error      string `json:"error"`      // 错误信息
+}
+
+// GroupBrokerItem 消费者组所在的 Broker
+type GroupBrokerItem struct {
+	BrokerName string `json:"brokerName"` // Broker 名称
+	BrokerAddr string `json:"brokerAddr"` // Master 地址
+	Exists     bool   `json:"exists"`     // 目标消费者组是否已存在
+}
+
+// GroupOffsetClonePlan 消费者组位点克隆预览
+type GroupOffsetClonePlan struct {
+	SourceGroup string            `json:"sourceGroup"` // 源消费者组
+	TargetGroup string            `json:"targetGroup"` // 目标消费者组
+	Brokers     []GroupBrokerItem `json:"brokers"`     // 源消费者组所在的 Broker
+	Offsets     []OffsetDiffItem  `json:"offsets"`     // 各队列位点变化（基准为目标组当前位点）
+}
+
+// GroupOffsetCloneResult 消费者组位点克隆结果
+type GroupOffsetCloneResult struct {
+	SourceGroup    string               `json:"sourceGroup"`    // 源消费者组
+	TargetGroup    string               `json:"targetGroup"`    // 目标消费者组
+	CreatedBrokers []string             `json:"createdBrokers"` // 新建目标消费者组的 Broker
+	FailedBrokers  []string             `json:"failedBrokers"`  // 创建目标消费者组失败的 Broker，其上的队列不写入位点
+	Errors         []string             `json:"errors"`         // 创建消费者组时的错误
+	Offsets        []OffsetUpdateResult `json:"offsets"`        // 各队列位点写入结果
+}
+
+// SkipBacklogItem 单个队列跳过堆积的预览
+type SkipBacklogItem struct {
+	Topic          string `json:"topic"`          // Topic 名称
+	BrokerName     string `json:"brokerName"`     // Broker 名称
+	QueueID        int    `json:"queueId"`        // 队列ID
+	ConsumerOffset int64  `json:"consumerOffset"` // 当前消费位点
+	MaxOffset      int64  `json:"maxOffset"`      // 跳过后的位点（队列最大位点）
+	Skipped        int64  `json:"skipped"`        // 将被跳过的消息数
+}
+
+// SkipBacklogPlan 跳过堆积预览
+type SkipBacklogPlan struct {
+	Group         string            `json:"group"`         // 消费者组名称
+	Topics        []string          `json:"topics"`        // 涉及的 Topic
+	OnlineClients int               `json:"onlineClients"` // 在线客户端数
+	TotalSkipped  int64             `json:"totalSkipped"`  // 将被跳过的消息总数
+	Queues        []SkipBacklogItem `json:"queues"`        // 各队列预览
+}
+
+// SkipBacklogRequest 跳过堆积请求
+type SkipBacklogRequest struct {
+	Group   string `json:"group"`   // 消费者组名称
+	Topic   string `json:"topic"`   // Topic 名称，为空表示全部订阅的 Topic
+	Force   bool   `json:"force"`   // 有在线客户端时是否强制执行
+	Confirm string `json:"confirm"` // 保护模式下需输入消费者组名称确认
+}
+
+// SkipBacklogResult 跳过堆积结果
+type SkipBacklogResult struct {
+	Group        string               `json:"group"`        // 消费者组名称
+	SnapshotID   string               `json:"snapshotId"`   // 跳过前自动创建的位点快照，没有在线客户端时可用于撤销
+	TotalSkipped int64                `json:"totalSkipped"` // 跳过的消息总数
+	Offsets      []OffsetUpdateResult `json:"offsets"`      // 各队列位点写入结果
+}
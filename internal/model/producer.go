@@ -0,0 +1,28 @@
+package model
+
+// ProducerClient 生产者客户端信息
+type ProducerClient struct {
+	ClientID    string `json:"clientId"`    // 客户端ID
+	IP          string `json:"ip"`          // 客户端地址
+	Language    string `json:"language"`    // 客户端语言
+	Version     string `json:"version"`     // 版本名称，如 V4_9_4
+	VersionCode int    `json:"versionCode"` // 客户端上报的原始版本号
+	BrokerName  string `json:"brokerName"`  // 最近一次上报心跳的 Broker
+	LastUpdate  string `json:"lastUpdate"`  // 最后心跳时间，取自 Broker 生产者心跳表
+}
+
+// ProducerGroupItem 生产者组信息
+type ProducerGroupItem struct {
+	ID            int              `json:"id"`            // 序号
+	Group         string           `json:"group"`         // 生产者组名称
+	OnlineClients int              `json:"onlineClients"` // 在线客户端数
+	Brokers       []string         `json:"brokers"`       // 存在该生产者组连接的 Broker
+	MixedVersions bool             `json:"mixedVersions"` // 同一语言的客户端版本是否不一致
+	Clients       []ProducerClient `json:"clients"`       // 客户端列表
+}
+
+// TopicProducers Topic 的生产者组
+type TopicProducers struct {
+	Topic  string               `json:"topic"`  // Topic 名称
+	Groups []*ProducerGroupItem `json:"groups"` // 连接到该 Topic 所在 Broker 的生产者组
+}
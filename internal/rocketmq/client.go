@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	rmq "github.com/apache/rocketmq-client-go/v2"
 	admin "github.com/codermast/rocketmq-admin-go"
 )
 
@@ -15,13 +16,26 @@ import (
 type AdminClientManager struct {
 	mu                       sync.RWMutex
 	clients                  map[string]*admin.Client // key: nameServer 地址
+	options                  map[string]clientOptions // key: nameServer 地址，创建客户端时的连接参数
+	producers                map[string]rmq.Producer  // key: nameServer 地址，按需创建的生产者
 	defaultConn              string                   // 默认连接的 NameServer 地址
 	defaultClientInitializer func() error             // 默认连接初始化器（懒连接）
 }
 
+// clientOptions 客户端连接参数
+type clientOptions struct {
+	timeout   time.Duration
+	enableACL bool
+	accessKey string
+	secretKey string
+	protected bool // 是否处于保护模式（生产环境），危险操作需二次确认
+}
+
 // 全局客户端管理器
 var clientManager = &AdminClientManager{
-	clients: make(map[string]*admin.Client),
+	clients:   make(map[string]*admin.Client),
+	options:   make(map[string]clientOptions),
+	producers: make(map[string]rmq.Producer),
 }
 
 // GetClientManager 获取客户端管理器实例
@@ -91,6 +105,7 @@ func (m *AdminClientManager) CreateClient(nameServer string, timeout time.Durati
 	if oldClient, exists := m.clients[nameServer]; exists {
 		oldClient.Close()
 	}
+	m.shutdownProducerLocked(nameServer)
 
 	options := []admin.Option{
 		admin.WithNameServers([]string{nameServer}),
@@ -116,9 +131,40 @@ func (m *AdminClientManager) CreateClient(nameServer string, timeout time.Durati
 	}
 
 	m.clients[nameServer] = client
+	m.options[nameServer] = clientOptions{
+		timeout:   timeout,
+		enableACL: enableACL,
+		accessKey: accessKey,
+		secretKey: secretKey,
+	}
 	return client, nil
 }
 
+// SetProtected 设置连接是否处于保护模式，连接未创建时忽略
+func (m *AdminClientManager) SetProtected(nameServer string, protected bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if opts, exists := m.options[nameServer]; exists {
+		opts.protected = protected
+		m.options[nameServer] = opts
+	}
+}
+
+// IsDefaultProtected 默认连接是否处于保护模式
+func (m *AdminClientManager) IsDefaultProtected() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.options[m.defaultConn].protected
+}
+
+// IsProtected 指定连接是否处于保护模式
+func (m *AdminClientManager) IsProtected(nameServer string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.options[nameServer].protected
+}
+
 // RemoveClient 移除并关闭客户端
 func (m *AdminClientManager) RemoveClient(nameServer string) {
 	m.mu.Lock()
@@ -128,6 +174,8 @@ func (m *AdminClientManager) RemoveClient(nameServer string) {
 		client.Close()
 		delete(m.clients, nameServer)
 	}
+	delete(m.options, nameServer)
+	m.shutdownProducerLocked(nameServer)
 
 	// 如果移除的是默认连接，清空默认连接
 	if m.defaultConn == nameServer {
@@ -200,6 +248,10 @@ func (m *AdminClientManager) CloseAll() {
 	for nameServer, client := range m.clients {
 		client.Close()
 		delete(m.clients, nameServer)
+		delete(m.options, nameServer)
+	}
+	for nameServer := range m.producers {
+		m.shutdownProducerLocked(nameServer)
 	}
 	m.defaultConn = ""
 }
@@ -0,0 +1,132 @@
+package rocketmq
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	rmq "github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/apache/rocketmq-client-go/v2/producer"
+)
+
+// ProducerGroup 应用内置生产者使用的生产者组
+const ProducerGroup = "ROCKET_LEAF_PRODUCER"
+
+// GetProducer 获取指定 NameServer 的生产者，首次使用时按该连接的参数（含 ACL）创建
+func (m *AdminClientManager) GetProducer(nameServer string) (rmq.Producer, error) {
+	m.mu.RLock()
+	p, exists := m.producers[nameServer]
+	m.mu.RUnlock()
+	if exists {
+		return p, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if p, exists := m.producers[nameServer]; exists {
+		return p, nil
+	}
+
+	opts, exists := m.options[nameServer]
+	if !exists {
+		return nil, fmt.Errorf("客户端未初始化: %s", nameServer)
+	}
+
+	producerOptions := []producer.Option{
+		producer.WithNameServer(primitive.NamesrvAddr{nameServer}),
+		producer.WithGroupName(ProducerGroup),
+		producer.WithInstanceName(fmt.Sprintf("rocket-leaf-%d-%s", os.Getpid(), strings.ReplaceAll(nameServer, ":", "_"))),
+		producer.WithQueueSelector(newQueueSelector()),
+		producer.WithRetry(2),
+	}
+	if opts.timeout > 0 {
+		producerOptions = append(producerOptions, producer.WithSendMsgTimeout(opts.timeout))
+	}
+	if opts.enableACL {
+		producerOptions = append(producerOptions, producer.WithCredentials(primitive.Credentials{
+			AccessKey: opts.accessKey,
+			SecretKey: opts.secretKey,
+		}))
+	}
+
+	p, err := rmq.NewProducer(producerOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("创建生产者失败: %w", err)
+	}
+	if err := p.Start(); err != nil {
+		return nil, fmt.Errorf("启动生产者失败: %w", err)
+	}
+
+	m.producers[nameServer] = p
+	return p, nil
+}
+
+// GetDefaultProducer 获取默认连接的生产者
+func (m *AdminClientManager) GetDefaultProducer() (rmq.Producer, error) {
+	if _, err := m.GetDefaultClient(); err != nil {
+		return nil, err
+	}
+
+	return m.GetProducer(m.GetDefaultConnection())
+}
+
+// shutdownProducerLocked 关闭并移除生产者，调用方需持有写锁
+func (m *AdminClientManager) shutdownProducerLocked(nameServer string) {
+	p, exists := m.producers[nameServer]
+	if !exists {
+		return
+	}
+
+	if err := p.Shutdown(); err != nil {
+		log.Printf("[AdminClientManager] 关闭生产者失败: %v", err)
+	}
+	delete(m.producers, nameServer)
+}
+
+// queueSelector 生产者队列选择器
+// 消息指定 Queue 时发送到该队列；设置了 ShardingKey 时按哈希选择队列以保证同一键有序；否则轮询，重试时避开上次失败的 Broker。
+type queueSelector struct {
+	counter uint64
+}
+
+func newQueueSelector() *queueSelector {
+	return &queueSelector{}
+}
+
+// Select 实现 producer.QueueSelector
+func (s *queueSelector) Select(msg *primitive.Message, queues []*primitive.MessageQueue, lastBrokerName string) *primitive.MessageQueue {
+	if len(queues) == 0 {
+		return nil
+	}
+
+	if msg.Queue != nil {
+		for _, queue := range queues {
+			if queue.BrokerName == msg.Queue.BrokerName && queue.QueueId == msg.Queue.QueueId {
+				return queue
+			}
+		}
+		// 指定的队列不在可写队列中时不发送，由生产者返回路由错误
+		return nil
+	}
+
+	if shardingKey := msg.GetProperty(primitive.PropertyShardingKey); shardingKey != "" {
+		hasher := fnv.New32a()
+		_, _ = hasher.Write([]byte(shardingKey))
+		return queues[hasher.Sum32()%uint32(len(queues))]
+	}
+
+	// 重试时 lastBrokerName 为上次失败的 Broker，轮询时优先避开
+	for range queues {
+		index := atomic.AddUint64(&s.counter, 1)
+		queue := queues[index%uint64(len(queues))]
+		if lastBrokerName == "" || queue.BrokerName != lastBrokerName {
+			return queue
+		}
+	}
+	return queues[atomic.AddUint64(&s.counter, 1)%uint64(len(queues))]
+}
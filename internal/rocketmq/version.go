@@ -0,0 +1,127 @@
+package rocketmq
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 客户端在请求头中上报的版本号是 RocketMQ MQVersion.Version 枚举的序号。
+// 下表按枚举声明顺序生成版本名称，新版本发布时只需调整 versionSegments。
+var versionNames = buildVersionNames()
+
+// versionSegment 描述一段连续的 Vmajor_minor_patch 版本，每个版本前都带一个 _SNAPSHOT 条目
+type versionSegment struct {
+	major      int
+	minor      int
+	firstPatch int
+	lastPatch  int
+}
+
+var versionSegments = func() []versionSegment {
+	segments := []versionSegment{{major: 3, minor: 0, firstPatch: 1, lastPatch: 15}}
+	for minor := 1; minor <= 9; minor++ {
+		segments = append(segments, versionSegment{major: 3, minor: minor, firstPatch: 0, lastPatch: 9})
+	}
+	for major := 4; major <= 5; major++ {
+		for minor := 0; minor <= 9; minor++ {
+			segments = append(segments, versionSegment{major: major, minor: minor, firstPatch: 0, lastPatch: 9})
+		}
+	}
+	return segments
+}()
+
+func buildVersionNames() []string {
+	names := []string{
+		"V3_0_0_SNAPSHOT",
+		"V3_0_0_ALPHA1",
+		"V3_0_0_BETA1",
+		"V3_0_0_BETA2",
+		"V3_0_0_BETA3",
+		"V3_0_0_BETA4",
+		"V3_0_0_BETA5",
+		"V3_0_0_BETA6_SNAPSHOT",
+		"V3_0_0_BETA6",
+		"V3_0_0_BETA7_SNAPSHOT",
+		"V3_0_0_BETA7",
+		"V3_0_0_BETA8_SNAPSHOT",
+		"V3_0_0_BETA8",
+		"V3_0_0_BETA9_SNAPSHOT",
+		"V3_0_0_BETA9",
+		"V3_0_0_FINAL",
+	}
+
+	for _, segment := range versionSegments {
+		for patch := segment.firstPatch; patch <= segment.lastPatch; patch++ {
+			name := fmt.Sprintf("V%d_%d_%d", segment.major, segment.minor, patch)
+			names = append(names, name+"_SNAPSHOT", name)
+		}
+	}
+
+	return append(names, "HIGHER_VERSION")
+}
+
+// MinRecommendedClientVersion 推荐的最低客户端版本，低于该版本的客户端在消费者组中标记为过旧。
+// 4.9.x 是 4.x 最后一个持续维护的分支，更早的版本已停止维护；调整推荐版本时只需修改此处。
+const MinRecommendedClientVersion = "V4_9_0"
+
+// versionComparableLanguage 版本号按 Java 客户端的 MQVersion 枚举编码，只有 Java 客户端的版本号能反映实际版本。
+// 其他语言的客户端上报固定值，如 rocketmq-client-go v2.1.2 始终上报 317（约为 V4_4_5）。
+const versionComparableLanguage = "JAVA"
+
+// IsOutdatedClient 判断客户端是否低于推荐版本，只判断 Java 客户端
+func IsOutdatedClient(language string, code int) bool {
+	return LanguageDesc(language) == versionComparableLanguage && code < VersionCode(MinRecommendedClientVersion)
+}
+
+// ClientVersionSet 按客户端语言收集版本号，不同语言的版本号不能互相比较，只在同一语言内判断版本混用
+type ClientVersionSet map[string]map[int]struct{}
+
+// Add 记录客户端的语言与版本号
+func (s ClientVersionSet) Add(language string, code int) {
+	language = LanguageDesc(language)
+	if s[language] == nil {
+		s[language] = make(map[int]struct{})
+	}
+	s[language][code] = struct{}{}
+}
+
+// Mixed 判断是否存在同一语言的客户端使用了不同版本
+func (s ClientVersionSet) Mixed() bool {
+	for _, codes := range s {
+		if len(codes) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// VersionDesc 将客户端上报的版本号转换为版本名称，如 V4_9_4
+func VersionDesc(code int) string {
+	if code < 0 {
+		return fmt.Sprintf("UNKNOWN(%d)", code)
+	}
+	if code >= len(versionNames) {
+		return versionNames[len(versionNames)-1]
+	}
+	return versionNames[code]
+}
+
+// VersionCode 将版本名称转换为版本号，未知版本返回 -1
+func VersionCode(desc string) int {
+	desc = strings.ToUpper(strings.TrimSpace(desc))
+	for code, name := range versionNames {
+		if name == desc {
+			return code
+		}
+	}
+	return -1
+}
+
+// LanguageDesc 规范化客户端语言标识
+func LanguageDesc(language string) string {
+	language = strings.ToUpper(strings.TrimSpace(language))
+	if language == "" {
+		return "UNKNOWN"
+	}
+	return language
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// resolveAppDataDir 解析应用数据目录下的子目录路径
+func resolveAppDataDir(subDir string) string {
+	configDir, err := os.UserConfigDir()
+	if err != nil || strings.TrimSpace(configDir) == "" {
+		return subDir
+	}
+
+	return filepath.Join(configDir, appConfigDirName, subDir)
+}
+
+// sanitizeFileName 将任意名称转换为可安全用作文件名的字符串
+func sanitizeFileName(name string) string {
+	name = unsafeFileNameChars.ReplaceAllString(strings.TrimSpace(name), "_")
+	if name == "" {
+		return "_"
+	}
+	return name
+}
+
+// writeJSONFile 以临时文件 + 重命名的方式原子写入 JSON 文件
+func writeJSONFile(path string, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tempFilePath := path + ".tmp"
+	if err := os.WriteFile(tempFilePath, data, 0o600); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tempFilePath, path); err != nil {
+		_ = os.Remove(tempFilePath)
+		return err
+	}
+
+	return nil
+}
+
+// readJSONFile 读取 JSON 文件，文件不存在时返回 false
+func readJSONFile(path string, value interface{}) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := json.Unmarshal(data, value); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -216,6 +216,14 @@ func formatNow() string {
 	return time.Now().Format("2006-01-02 15:04:05")
 }
 
+// formatTimestamp 格式化毫秒时间戳，非正数返回 "-"
+func formatTimestamp(timestamp int64) string {
+	if timestamp <= 0 {
+		return "-"
+	}
+	return time.UnixMilli(timestamp).Format("2006-01-02 15:04:05")
+}
+
 // GetConnections 获取所有连接配置
 func (s *ConnectionService) GetConnections() []*model.Connection {
 	s.mu.RLock()
@@ -320,6 +328,7 @@ func (s *ConnectionService) UpdateConnection(id int, name string, env string, na
 	if oldNameServer != nameServer {
 		rocketmq.GetClientManager().RemoveClient(oldNameServer)
 	}
+	rocketmq.GetClientManager().SetProtected(nameServer, connEnv == model.EnvProduction)
 
 	return conn, nil
 }
@@ -484,6 +493,7 @@ func (s *ConnectionService) ConnectDefault() error {
 	if err != nil {
 		return err
 	}
+	rocketmq.GetClientManager().SetProtected(defaultConn.NameServer, defaultConn.Env == model.EnvProduction)
 
 	return rocketmq.GetClientManager().SetDefaultConnection(defaultConn.NameServer)
 }
@@ -501,12 +511,14 @@ func (s *ConnectionService) Connect(id int) error {
 	enableACL := conn.EnableACL
 	accessKey := conn.AccessKey
 	secretKey := conn.SecretKey
+	protected := conn.Env == model.EnvProduction
 	s.mu.RUnlock()
 
 	_, err := rocketmq.GetClientManager().CreateClient(nameServer, timeout, enableACL, accessKey, secretKey)
 	if err != nil {
 		return err
 	}
+	rocketmq.GetClientManager().SetProtected(nameServer, protected)
 
 	// 更新连接状态
 	s.mu.Lock()
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// queueOwnership 由客户端运行时信息汇总的队列归属
+type queueOwnership struct {
+	owners       map[string][]string            // 队列 -> 持有该队列的客户端
+	clientTopics map[string]map[string]struct{} // 客户端 -> 订阅的 Topic
+	clientIDs    []string                       // 可访问的客户端
+	unreachable  map[string]string              // 无法访问的客户端 -> 错误信息
+}
+
+// GetQueueAllocation 获取消费者组各 Topic 的队列到客户端的分配情况
+// 队列归属来自各客户端上报的处理队列，位点与堆积量来自消费统计；重试 Topic 不参与分析。
+func (s *ConsumerService) GetQueueAllocation(groupName string) (*model.QueueAllocationResult, error) {
+	groupName = strings.TrimSpace(groupName)
+	if groupName == "" {
+		return nil, fmt.Errorf("获取队列分配失败: 消费者组名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	connInfo, err := fetchConsumerConnection(client, groupName)
+	if err != nil {
+		return nil, fmt.Errorf("获取消费者连接信息失败: %w", err)
+	}
+	offsets, err := fetchQueueOffsets(client, groupName)
+	if err != nil {
+		return nil, fmt.Errorf("获取消费位点失败: %w", err)
+	}
+
+	result := &model.QueueAllocationResult{
+		Group:     groupName,
+		Topics:    make([]model.TopicAllocation, 0),
+		Issues:    make([]model.AllocationIssue, 0),
+		CheckedAt: formatNow(),
+	}
+	if connInfo != nil {
+		result.MessageModel = connInfo.MessageModel
+	}
+
+	ownership := collectQueueOwnership(client, groupName, connInfo)
+	for _, clientID := range sortedKeys(ownership.unreachable) {
+		result.Issues = append(result.Issues, model.AllocationIssue{
+			Type:      model.AllocationClientUnreachable,
+			Message:   fmt.Sprintf("无法获取客户端运行时信息: %s", ownership.unreachable[clientID]),
+			ClientIDs: []string{clientID},
+		})
+	}
+
+	topicQueues := make(map[string][]model.QueueOffset)
+	topics := make([]string, 0)
+	for _, offset := range offsets {
+		if strings.HasPrefix(offset.Topic, retryTopicPrefix) {
+			continue
+		}
+		if _, ok := topicQueues[offset.Topic]; !ok {
+			topics = append(topics, offset.Topic)
+		}
+		topicQueues[offset.Topic] = append(topicQueues[offset.Topic], offset)
+	}
+
+	// 广播模式下每个客户端消费全部队列，不存在分配关系
+	broadcasting := strings.EqualFold(result.MessageModel, string(model.ModeBroadcasting))
+
+	for _, topic := range topics {
+		allocation := model.TopicAllocation{
+			Topic:       topic,
+			IdleClients: make([]string, 0),
+			Queues:      make([]model.QueueAssignment, 0, len(topicQueues[topic])),
+			Clients:     make([]model.ClientQueueLoad, 0),
+		}
+
+		loads := make(map[string]*model.ClientQueueLoad)
+		for _, clientID := range ownership.clientIDs {
+			if _, subscribed := ownership.clientTopics[clientID][topic]; subscribed {
+				loads[clientID] = &model.ClientQueueLoad{ClientID: clientID}
+			}
+		}
+		allocation.ClientCount = len(loads)
+
+		for _, offset := range topicQueues[topic] {
+			lag := offset.BrokerOffset - offset.ConsumerOffset
+			if lag < 0 {
+				lag = 0
+			}
+			owners := ownership.owners[queueOffsetKey(offset.Topic, offset.BrokerName, offset.QueueID)]
+			queue := model.QueueAssignment{
+				Topic:          offset.Topic,
+				BrokerName:     offset.BrokerName,
+				QueueID:        offset.QueueID,
+				ClientIDs:      append([]string{}, owners...),
+				BrokerOffset:   offset.BrokerOffset,
+				ConsumerOffset: offset.ConsumerOffset,
+				Lag:            lag,
+			}
+			allocation.Queues = append(allocation.Queues, queue)
+			allocation.QueueCount++
+
+			for _, owner := range owners {
+				load, ok := loads[owner]
+				if !ok {
+					load = &model.ClientQueueLoad{ClientID: owner}
+					loads[owner] = load
+				}
+				load.QueueCount++
+				load.Lag += lag
+			}
+
+			if broadcasting {
+				continue
+			}
+			switch {
+			case len(owners) == 0:
+				allocation.UnassignedQueues++
+			case len(owners) > 1:
+				result.Issues = append(result.Issues, model.AllocationIssue{
+					Type:      model.AllocationDuplicateOwner,
+					Topic:     topic,
+					Message:   fmt.Sprintf("队列 %s-%d 同时被 %d 个客户端持有，可能正在重平衡", offset.BrokerName, offset.QueueID, len(owners)),
+					ClientIDs: queue.ClientIDs,
+				})
+			}
+		}
+
+		for _, clientID := range sortedKeys(loads) {
+			allocation.Clients = append(allocation.Clients, *loads[clientID])
+		}
+
+		if !broadcasting {
+			analyzeTopicAllocation(&allocation, result, len(ownership.unreachable) > 0)
+		}
+		result.Topics = append(result.Topics, allocation)
+	}
+
+	return result, nil
+}
+
+// analyzeTopicAllocation 标记未分配队列、空闲客户端和分配不均
+// 存在无法访问的客户端时，其持有的队列会显示为未分配，因此不报告未分配问题以免误报。
+func analyzeTopicAllocation(allocation *model.TopicAllocation, result *model.QueueAllocationResult, partial bool) {
+	if allocation.UnassignedQueues > 0 && allocation.ClientCount > 0 && !partial {
+		result.Issues = append(result.Issues, model.AllocationIssue{
+			Type:    model.AllocationUnassignedQueue,
+			Topic:   allocation.Topic,
+			Message: fmt.Sprintf("Topic %s 有 %d 个队列未分配给任何客户端", allocation.Topic, allocation.UnassignedQueues),
+		})
+	}
+
+	minQueues, maxQueues := -1, 0
+	for _, load := range allocation.Clients {
+		if load.QueueCount == 0 {
+			allocation.IdleClients = append(allocation.IdleClients, load.ClientID)
+		}
+		if minQueues < 0 || load.QueueCount < minQueues {
+			minQueues = load.QueueCount
+		}
+		if load.QueueCount > maxQueues {
+			maxQueues = load.QueueCount
+		}
+	}
+
+	if len(allocation.IdleClients) > 0 {
+		message := fmt.Sprintf("Topic %s 有 %d 个客户端未分配到队列", allocation.Topic, len(allocation.IdleClients))
+		if allocation.ClientCount > allocation.QueueCount {
+			message = fmt.Sprintf("Topic %s 仅有 %d 个队列，但有 %d 个客户端，多出的客户端不会消费消息",
+				allocation.Topic, allocation.QueueCount, allocation.ClientCount)
+		}
+		result.Issues = append(result.Issues, model.AllocationIssue{
+			Type:      model.AllocationIdleClient,
+			Topic:     allocation.Topic,
+			Message:   message,
+			ClientIDs: allocation.IdleClients,
+		})
+	}
+
+	// 平均分配策略下各客户端队列数最多相差 1
+	if minQueues >= 0 && maxQueues-minQueues > 1 {
+		allocation.Imbalanced = true
+		result.Issues = append(result.Issues, model.AllocationIssue{
+			Type:    model.AllocationImbalance,
+			Topic:   allocation.Topic,
+			Message: fmt.Sprintf("Topic %s 队列分配不均: 客户端持有队列数在 %d 到 %d 之间", allocation.Topic, minQueues, maxQueues),
+		})
+	}
+}
+
+// collectQueueOwnership 逐个获取客户端运行时信息，汇总队列归属与订阅 Topic
+func collectQueueOwnership(client *admin.Client, group string, connInfo *admin.ConsumerConnection) *queueOwnership {
+	ownership := &queueOwnership{
+		owners:       make(map[string][]string),
+		clientTopics: make(map[string]map[string]struct{}),
+		clientIDs:    make([]string, 0),
+		unreachable:  make(map[string]string),
+	}
+	if connInfo == nil {
+		return ownership
+	}
+
+	for _, conn := range connInfo.ConnectionSet {
+		runningInfo, err := fetchConsumerRunningInfo(client, group, conn.ClientId, false)
+		if err != nil {
+			ownership.unreachable[conn.ClientId] = err.Error()
+			continue
+		}
+
+		topics := make(map[string]struct{})
+		for _, data := range runningInfo.SubscriptionSet {
+			if data != nil {
+				topics[data.Topic] = struct{}{}
+			}
+		}
+		ownership.clientTopics[conn.ClientId] = topics
+		ownership.clientIDs = append(ownership.clientIDs, conn.ClientId)
+
+		for mq, pq := range runningInfo.MqTable {
+			if pq == nil || pq.Droped {
+				continue
+			}
+			key := queueOffsetKey(mq.Topic, mq.BrokerName, mq.QueueId)
+			ownership.owners[key] = append(ownership.owners[key], conn.ClientId)
+		}
+	}
+
+	sort.Strings(ownership.clientIDs)
+	for key := range ownership.owners {
+		sort.Strings(ownership.owners[key])
+	}
+	return ownership
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
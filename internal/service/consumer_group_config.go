@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// 与 Broker 端 SubscriptionGroupConfig 一致的默认值
+const (
+	defaultGroupRetryMaxTimes        = 16
+	defaultGroupRetryQueueNums       = 1
+	defaultGroupConsumeTimeoutMinute = 15
+	defaultWhichBrokerConsumeSlowly  = 1
+)
+
+// 可通过 ConsumerGroupConfig.Fields 指定写入的配置字段（JSON 字段名）
+const (
+	groupFieldConsumeMode              = "consumeMode"
+	groupFieldConsumeEnable            = "consumeEnable"
+	groupFieldConsumeFromMinEnable     = "consumeFromMinEnable"
+	groupFieldConsumeOrderly           = "consumeOrderly"
+	groupFieldMaxRetry                 = "maxRetry"
+	groupFieldRetryQueueNums           = "retryQueueNums"
+	groupFieldBrokerID                 = "brokerId"
+	groupFieldWhichBrokerConsumeSlowly = "whichBrokerWhenConsumeSlowly"
+	groupFieldNotifyConsumerIdsChanged = "notifyConsumerIdsChanged"
+	groupFieldGroupSysFlag             = "groupSysFlag"
+	groupFieldConsumeTimeoutMinute     = "consumeTimeoutMinute"
+	groupFieldAttributes               = "attributes"
+)
+
+var groupConfigFields = []string{
+	groupFieldConsumeMode,
+	groupFieldConsumeEnable,
+	groupFieldConsumeFromMinEnable,
+	groupFieldConsumeOrderly,
+	groupFieldMaxRetry,
+	groupFieldRetryQueueNums,
+	groupFieldBrokerID,
+	groupFieldWhichBrokerConsumeSlowly,
+	groupFieldNotifyConsumerIdsChanged,
+	groupFieldGroupSysFlag,
+	groupFieldConsumeTimeoutMinute,
+	groupFieldAttributes,
+}
+
+// GetConsumerGroupConfig 读取消费者组当前配置
+// 以第一个存在该消费者组的 Broker 上的配置为准，Brokers 字段列出所有存在该消费者组的 Broker。
+func (s *ConsumerService) GetConsumerGroupConfig(groupName string) (*model.ConsumerGroupConfig, error) {
+	groupName = strings.TrimSpace(groupName)
+	if groupName == "" {
+		return nil, fmt.Errorf("获取消费者组配置失败: 消费者组名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	brokerConfigs, err := fetchGroupBrokerConfigs(client, groupName)
+	if err != nil {
+		return nil, fmt.Errorf("获取消费者组配置失败: %w", err)
+	}
+
+	var result *model.ConsumerGroupConfig
+	brokers := make([]string, 0)
+	for _, item := range brokerConfigs {
+		if item.config == nil {
+			continue
+		}
+		if result == nil {
+			result = toConsumerGroupConfig(item.config)
+		}
+		brokers = append(brokers, item.brokerName)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("消费者组不存在: %s", groupName)
+	}
+
+	result.Brokers = brokers
+	return result, nil
+}
+
+// CreateConsumerGroupWithConfig 按完整配置创建消费者组
+// 指定 BrokerAddr 时仅在该 Broker 上创建；否则在 Cluster 内（为空时为所有集群）的全部 Master 上创建。
+// Fields 为空时写入全部字段，否则只写入列出的字段，其余字段在新 Broker 上取默认配置；
+// 已存在该消费者组的 Broker 会在原配置基础上更新，不会丢失未写入的字段。
+func (s *ConsumerService) CreateConsumerGroupWithConfig(config model.ConsumerGroupConfig) ([]model.BrokerOperationResult, error) {
+	config.Group = strings.TrimSpace(config.Group)
+	if config.Group == "" {
+		return nil, fmt.Errorf("创建消费者组失败: 消费者组名称不能为空")
+	}
+
+	fields, err := resolveGroupConfigFields(config, false)
+	if err != nil {
+		return nil, fmt.Errorf("创建消费者组失败: %w", err)
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	targets, err := resolveGroupConfigTargets(client, config, false)
+	if err != nil {
+		return nil, fmt.Errorf("创建消费者组失败: %w", err)
+	}
+
+	return saveGroupBrokerConfigs(client, targets, config, fields), nil
+}
+
+// UpdateConsumerGroupConfig 更新消费者组配置
+// 只更新已存在该消费者组的 Broker（指定 BrokerAddr 时仅更新该 Broker），并以各 Broker 上的当前配置为基础，
+// 只修改 Fields 中列出的字段，未列出的字段保持 Broker 上的当前值。
+func (s *ConsumerService) UpdateConsumerGroupConfig(config model.ConsumerGroupConfig) ([]model.BrokerOperationResult, error) {
+	config.Group = strings.TrimSpace(config.Group)
+	if config.Group == "" {
+		return nil, fmt.Errorf("更新消费者组失败: 消费者组名称不能为空")
+	}
+
+	fields, err := resolveGroupConfigFields(config, true)
+	if err != nil {
+		return nil, fmt.Errorf("更新消费者组失败: %w", err)
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	targets, err := resolveGroupConfigTargets(client, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("更新消费者组失败: %w", err)
+	}
+
+	return saveGroupBrokerConfigs(client, targets, config, fields), nil
+}
+
+// resolveGroupConfigFields 解析需要写入的字段并校验取值，requireFields 为 true 时必须显式指定字段
+func resolveGroupConfigFields(config model.ConsumerGroupConfig, requireFields bool) (map[string]struct{}, error) {
+	fields := make(map[string]struct{}, len(groupConfigFields))
+	if len(config.Fields) == 0 {
+		if requireFields {
+			return nil, fmt.Errorf("未指定需要修改的字段")
+		}
+		for _, field := range groupConfigFields {
+			fields[field] = struct{}{}
+		}
+	}
+
+	for _, field := range config.Fields {
+		field = strings.TrimSpace(field)
+		known := false
+		for _, candidate := range groupConfigFields {
+			if candidate == field {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("不支持修改的字段: %s", field)
+		}
+		fields[field] = struct{}{}
+	}
+
+	if _, ok := fields[groupFieldMaxRetry]; ok && config.MaxRetry < 0 {
+		return nil, fmt.Errorf("最大重试次数不能小于 0")
+	}
+	if _, ok := fields[groupFieldRetryQueueNums]; ok && config.RetryQueueNums <= 0 {
+		return nil, fmt.Errorf("重试队列数必须大于 0")
+	}
+	if _, ok := fields[groupFieldConsumeTimeoutMinute]; ok && config.ConsumeTimeoutMinute <= 0 {
+		return nil, fmt.Errorf("消费超时时间必须大于 0")
+	}
+	if _, ok := fields[groupFieldConsumeMode]; ok && config.ConsumeMode != "" && config.ConsumeMode != model.ModeClustering && config.ConsumeMode != model.ModeBroadcasting {
+		return nil, fmt.Errorf("不支持的消费模式: %s", config.ConsumeMode)
+	}
+
+	return fields, nil
+}
+
+// resolveGroupConfigTargets 确定需要写入配置的 Broker，existingOnly 为 true 时只返回已存在该消费者组的 Broker
+func resolveGroupConfigTargets(client *admin.Client, config model.ConsumerGroupConfig, existingOnly bool) ([]groupBrokerConfig, error) {
+	brokerConfigs, err := fetchGroupBrokerConfigs(client, config.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	brokerAddr := strings.TrimSpace(config.BrokerAddr)
+	cluster := strings.TrimSpace(config.Cluster)
+
+	var clusterBrokers map[string]struct{}
+	if brokerAddr == "" && cluster != "" {
+		clusterBrokers, err = fetchClusterBrokerNames(client, cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	targets := make([]groupBrokerConfig, 0, len(brokerConfigs))
+	for _, item := range brokerConfigs {
+		if brokerAddr != "" && item.brokerAddr != brokerAddr {
+			continue
+		}
+		if clusterBrokers != nil {
+			if _, ok := clusterBrokers[item.brokerName]; !ok {
+				continue
+			}
+		}
+		if existingOnly && item.config == nil {
+			continue
+		}
+		targets = append(targets, item)
+	}
+
+	if len(targets) == 0 {
+		switch {
+		case existingOnly:
+			return nil, fmt.Errorf("未找到存在消费者组 %s 的 Broker", config.Group)
+		case brokerAddr != "":
+			return nil, fmt.Errorf("Broker 不存在或不是 Master: %s", brokerAddr)
+		case cluster != "":
+			return nil, fmt.Errorf("集群 %s 中没有可用的 Master Broker", cluster)
+		default:
+			return nil, fmt.Errorf("没有可用的 Master Broker")
+		}
+	}
+
+	return targets, nil
+}
+
+// fetchClusterBrokerNames 获取集群内的 BrokerName 集合
+func fetchClusterBrokerNames(client *admin.Client, cluster string) (map[string]struct{}, error) {
+	result := make(map[string]struct{})
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		clusterInfo, callErr := retryClient.ExamineBrokerClusterInfo(ctx)
+		if callErr != nil {
+			return callErr
+		}
+
+		brokerNames, ok := clusterInfo.ClusterAddrTable[cluster]
+		if !ok {
+			return fmt.Errorf("集群不存在: %s", cluster)
+		}
+		for _, brokerName := range brokerNames {
+			result[brokerName] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// saveGroupBrokerConfigs 将配置逐个写入目标 Broker
+func saveGroupBrokerConfigs(client *admin.Client, targets []groupBrokerConfig, config model.ConsumerGroupConfig, fields map[string]struct{}) []model.BrokerOperationResult {
+	results := make([]model.BrokerOperationResult, 0, len(targets))
+	for _, target := range targets {
+		brokerConfig := mergeSubscriptionGroupConfig(target.config, config, fields)
+		err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			return retryClient.CreateSubscriptionGroup(ctx, target.brokerAddr, brokerConfig)
+		})
+
+		result := model.BrokerOperationResult{
+			BrokerName: target.brokerName,
+			BrokerAddr: target.brokerAddr,
+			Success:    err == nil,
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// mergeSubscriptionGroupConfig 在 Broker 当前配置（为 nil 时取默认配置）的基础上写入 fields 中的字段
+func mergeSubscriptionGroupConfig(base *admin.SubscriptionGroupConfig, config model.ConsumerGroupConfig, fields map[string]struct{}) admin.SubscriptionGroupConfig {
+	var result admin.SubscriptionGroupConfig
+	if base != nil {
+		result = *base
+	} else {
+		result = admin.SubscriptionGroupConfig{
+			ConsumeEnable:                  true,
+			ConsumeFromMinEnable:           true,
+			RetryMaxTimes:                  defaultGroupRetryMaxTimes,
+			RetryQueueNums:                 defaultGroupRetryQueueNums,
+			WhichBrokerWhenConsumeSlowly:   defaultWhichBrokerConsumeSlowly,
+			NotifyConsumerIdsChangedEnable: true,
+			ConsumeTimeoutMinute:           defaultGroupConsumeTimeoutMinute,
+		}
+	}
+	result.GroupName = config.Group
+
+	for field := range fields {
+		switch field {
+		case groupFieldConsumeMode:
+			result.ConsumeBroadcastEnable = config.ConsumeMode == model.ModeBroadcasting
+		case groupFieldConsumeEnable:
+			result.ConsumeEnable = config.ConsumeEnable
+		case groupFieldConsumeFromMinEnable:
+			result.ConsumeFromMinEnable = config.ConsumeFromMinEnable
+		case groupFieldConsumeOrderly:
+			result.ConsumeMessageOrderly = config.ConsumeOrderly
+		case groupFieldMaxRetry:
+			result.RetryMaxTimes = config.MaxRetry
+		case groupFieldRetryQueueNums:
+			result.RetryQueueNums = config.RetryQueueNums
+		case groupFieldBrokerID:
+			result.BrokerId = config.BrokerID
+		case groupFieldWhichBrokerConsumeSlowly:
+			result.WhichBrokerWhenConsumeSlowly = config.WhichBrokerWhenConsumeSlowly
+		case groupFieldNotifyConsumerIdsChanged:
+			result.NotifyConsumerIdsChangedEnable = config.NotifyConsumerIdsChanged
+		case groupFieldGroupSysFlag:
+			result.GroupSysFlag = config.GroupSysFlag
+		case groupFieldConsumeTimeoutMinute:
+			result.ConsumeTimeoutMinute = config.ConsumeTimeoutMinute
+		case groupFieldAttributes:
+			result.Attributes = config.Attributes
+		}
+	}
+
+	return result
+}
+
+func toConsumerGroupConfig(config *admin.SubscriptionGroupConfig) *model.ConsumerGroupConfig {
+	consumeMode := model.ModeClustering
+	if config.ConsumeBroadcastEnable {
+		consumeMode = model.ModeBroadcasting
+	}
+
+	attributes := make(map[string]string, len(config.Attributes))
+	for key, value := range config.Attributes {
+		attributes[key] = value
+	}
+
+	return &model.ConsumerGroupConfig{
+		Group:                        config.GroupName,
+		ConsumeMode:                  consumeMode,
+		ConsumeEnable:                config.ConsumeEnable,
+		ConsumeFromMinEnable:         config.ConsumeFromMinEnable,
+		ConsumeOrderly:               config.ConsumeMessageOrderly,
+		MaxRetry:                     config.RetryMaxTimes,
+		RetryQueueNums:               config.RetryQueueNums,
+		BrokerID:                     config.BrokerId,
+		WhichBrokerWhenConsumeSlowly: config.WhichBrokerWhenConsumeSlowly,
+		NotifyConsumerIdsChanged:     config.NotifyConsumerIdsChangedEnable,
+		GroupSysFlag:                 config.GroupSysFlag,
+		ConsumeTimeoutMinute:         config.ConsumeTimeoutMinute,
+		Attributes:                   attributes,
+	}
+}
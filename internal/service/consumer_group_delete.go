@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const dlqTopicPrefix = "%DLQ%"
+
+// DeleteConsumerGroupEverywhere 在所有存在该消费者组的 Broker 上删除消费者组
+// 可选同时删除重试 Topic、死信 Topic 以及 Broker 上保存的消费位点；有在线客户端时默认拒绝执行，
+// 保护模式下需输入消费者组名称确认。任一 Broker 删除失败时不清理 Topic，避免仍存在的消费者组丢失重试与死信消息。
+func (s *ConsumerService) DeleteConsumerGroupEverywhere(req model.DeleteGroupRequest) (*model.DeleteGroupResult, error) {
+	group := strings.TrimSpace(req.Group)
+	if group == "" {
+		return nil, fmt.Errorf("删除消费者组失败: 消费者组名称不能为空")
+	}
+	if err := checkProtectionConfirm("删除消费者组", req.Confirm, group); err != nil {
+		return nil, err
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	if !req.Force {
+		online, err := countOnlineClients(client, group)
+		if err != nil {
+			return nil, fmt.Errorf("删除消费者组失败: 无法确认消费者组 %s 是否有在线客户端，请稍后重试或强制删除: %w", group, err)
+		}
+		if online > 0 {
+			return nil, fmt.Errorf("删除消费者组失败: 消费者组 %s 仍有 %d 个在线客户端，请先停止消费或强制删除", group, online)
+		}
+	}
+
+	brokerConfigs, err := fetchGroupBrokerConfigs(client, group)
+	if err != nil {
+		return nil, fmt.Errorf("删除消费者组失败: %w", err)
+	}
+
+	result := &model.DeleteGroupResult{
+		Group:   group,
+		Brokers: make([]model.BrokerOperationResult, 0),
+		Topics:  make([]model.TopicCleanupResult, 0),
+	}
+
+	brokerFailed := false
+	for _, item := range brokerConfigs {
+		if item.config == nil {
+			continue
+		}
+
+		err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			return retryClient.DeleteSubscriptionGroupWithOffset(ctx, item.brokerAddr, group, req.CleanOffsets)
+		})
+
+		brokerResult := model.BrokerOperationResult{
+			BrokerName: item.brokerName,
+			BrokerAddr: item.brokerAddr,
+			Success:    err == nil,
+		}
+		if err != nil {
+			brokerFailed = true
+			brokerResult.Error = err.Error()
+		}
+		result.Brokers = append(result.Brokers, brokerResult)
+	}
+
+	topics := make([]string, 0, 2)
+	if req.CleanRetryTopic {
+		topics = append(topics, retryTopicPrefix+group)
+	}
+	if req.CleanDLQTopic {
+		topics = append(topics, dlqTopicPrefix+group)
+	}
+	for _, topic := range topics {
+		if brokerFailed {
+			result.Topics = append(result.Topics, model.TopicCleanupResult{
+				Topic:   topic,
+				Skipped: true,
+				Error:   "部分 Broker 删除消费者组失败，未清理该 Topic，请重试删除后再清理",
+			})
+			continue
+		}
+		result.Topics = append(result.Topics, cleanupTopic(client, topic))
+	}
+
+	if len(result.Brokers) == 0 && len(result.Topics) == 0 {
+		return nil, fmt.Errorf("删除消费者组失败: 未在任何 Broker 上找到消费者组 %s", group)
+	}
+
+	return result, nil
+}
+
+// cleanupTopic 删除 Topic，Topic 不存在时标记为跳过
+func cleanupTopic(client *admin.Client, topic string) model.TopicCleanupResult {
+	result := model.TopicCleanupResult{Topic: topic}
+
+	routeErr := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		_, callErr := retryClient.ExamineTopicRouteInfo(ctx, topic)
+		return callErr
+	})
+	if routeErr != nil {
+		result.Skipped = true
+		result.Error = fmt.Sprintf("Topic 不存在或无路由: %v", routeErr)
+		return result
+	}
+
+	if err := deleteTopicInClusters(client, topic, ""); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Success = true
+	return result
+}
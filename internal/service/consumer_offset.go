@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// fetchQueueOffsets 获取消费者组在各队列上的消费位点
+func fetchQueueOffsets(client *admin.Client, group string) ([]model.QueueOffset, error) {
+	result := make([]model.QueueOffset, 0)
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		stats, callErr := retryClient.ExamineConsumeStats(ctx, group)
+		if callErr != nil {
+			return callErr
+		}
+
+		tmpResult := make([]model.QueueOffset, 0, len(stats.OffsetTable))
+		for mq, offset := range stats.OffsetTable {
+			tmpResult = append(tmpResult, model.QueueOffset{
+				Group:          group,
+				Topic:          mq.Topic,
+				BrokerName:     mq.BrokerName,
+				QueueID:        mq.QueueId,
+				BrokerOffset:   offset.BrokerOffset,
+				ConsumerOffset: offset.ConsumerOffset,
+				LastTimestamp:  offset.LastTimestamp,
+			})
+		}
+
+		result = tmpResult
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortQueueOffsets(result)
+	return result, nil
+}
+
+// fetchMasterAddrs 获取 BrokerName 到 Master 地址的映射
+func fetchMasterAddrs(client *admin.Client) (map[string]string, error) {
+	result := make(map[string]string)
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		clusterInfo, callErr := retryClient.ExamineBrokerClusterInfo(ctx)
+		if callErr != nil {
+			return callErr
+		}
+
+		tmpResult := make(map[string]string, len(clusterInfo.BrokerAddrTable))
+		for brokerName, brokerData := range clusterInfo.BrokerAddrTable {
+			if brokerData == nil {
+				continue
+			}
+			if addr, ok := brokerData.BrokerAddrs["0"]; ok && addr != "" {
+				tmpResult[brokerName] = addr
+			}
+		}
+
+		result = tmpResult
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// countOnlineClients 获取消费者组当前在线客户端数量
+// Broker 在消费者组没有在线客户端时返回 CONSUMER_NOT_ONLINE 错误，此时视为 0；其他错误原样返回，
+// 调用方无法确认是否有在线客户端时应拒绝执行。
+func countOnlineClients(client *admin.Client, group string) (int, error) {
+	connInfo, err := fetchConsumerConnection(client, group)
+	if err != nil {
+		if isConsumerNotOnlineError(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if connInfo == nil {
+		return 0, nil
+	}
+	return len(connInfo.ConnectionSet), nil
+}
+
+// isConsumerNotOnlineError 判断是否为消费者组没有在线客户端的错误（CONSUMER_NOT_ONLINE）
+func isConsumerNotOnlineError(err error) bool {
+	errMsg := strings.ToLower(err.Error())
+	return strings.Contains(errMsg, "not online") || strings.Contains(errMsg, "consumer_not_online")
+}
+
+// updateQueueOffsets 将位点逐个写入各队列所在的 Master Broker
+func updateQueueOffsets(client *admin.Client, group string, offsets []model.QueueOffset, masterAddrs map[string]string) []model.OffsetUpdateResult {
+	results := make([]model.OffsetUpdateResult, 0, len(offsets))
+	for _, offset := range offsets {
+		result := model.OffsetUpdateResult{
+			Topic:      offset.Topic,
+			BrokerName: offset.BrokerName,
+			QueueID:    offset.QueueID,
+			Offset:     offset.ConsumerOffset,
+		}
+
+		brokerAddr, ok := masterAddrs[offset.BrokerName]
+		if !ok {
+			result.Error = fmt.Sprintf("Broker 不存在或无 Master: %s", offset.BrokerName)
+			results = append(results, result)
+			continue
+		}
+		result.BrokerAddr = brokerAddr
+
+		mq := admin.MessageQueue{
+			Topic:      offset.Topic,
+			BrokerName: offset.BrokerName,
+			QueueId:    offset.QueueID,
+		}
+		err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			return retryClient.UpdateConsumeOffset(ctx, brokerAddr, group, mq, offset.ConsumerOffset)
+		})
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Success = true
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func queueOffsetKey(topic string, brokerName string, queueID int) string {
+	return fmt.Sprintf("%s@%s@%d", topic, brokerName, queueID)
+}
+
+func sortQueueOffsets(offsets []model.QueueOffset) {
+	sort.Slice(offsets, func(i, j int) bool {
+		if offsets[i].Topic != offsets[j].Topic {
+			return offsets[i].Topic < offsets[j].Topic
+		}
+		if offsets[i].BrokerName != offsets[j].BrokerName {
+			return offsets[i].BrokerName < offsets[j].BrokerName
+		}
+		return offsets[i].QueueID < offsets[j].QueueID
+	})
+}
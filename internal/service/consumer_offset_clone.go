@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// PreviewCloneGroupOffsets 预览将源消费者组位点克隆到目标消费者组的效果
+// topics 为空时包含源消费者组的全部 Topic。
+func (s *ConsumerService) PreviewCloneGroupOffsets(sourceGroup string, targetGroup string, topics []string) (*model.GroupOffsetClonePlan, error) {
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	plan, _, err := buildGroupOffsetClonePlan(client, sourceGroup, targetGroup, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// CloneGroupOffsets 克隆消费者组位点
+// 在源消费者组所在的每个 Broker 上创建缺失的目标消费者组（沿用源组配置），再逐队列写入源组位点；
+// 创建失败的 Broker 记入 FailedBrokers，其上的队列跳过写入。
+// 目标消费者组有在线客户端时默认拒绝执行，force 为 true 时忽略该检查。
+func (s *ConsumerService) CloneGroupOffsets(sourceGroup string, targetGroup string, topics []string, force bool) (*model.GroupOffsetCloneResult, error) {
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	plan, sourceBrokers, err := buildGroupOffsetClonePlan(client, sourceGroup, targetGroup, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	if !force {
+		online, err := countOnlineClients(client, plan.TargetGroup)
+		if err != nil {
+			return nil, fmt.Errorf("克隆消费位点失败: 无法确认目标消费者组 %s 是否有在线客户端，请稍后重试或强制执行: %w", plan.TargetGroup, err)
+		}
+		if online > 0 {
+			return nil, fmt.Errorf("克隆消费位点失败: 目标消费者组 %s 仍有 %d 个在线客户端，请先停止消费或强制执行", plan.TargetGroup, online)
+		}
+	}
+
+	result := &model.GroupOffsetCloneResult{
+		SourceGroup:    plan.SourceGroup,
+		TargetGroup:    plan.TargetGroup,
+		CreatedBrokers: make([]string, 0),
+		FailedBrokers:  make([]string, 0),
+		Errors:         make([]string, 0),
+	}
+
+	masterAddrs := make(map[string]string, len(sourceBrokers))
+	failed := make(map[string]struct{})
+	for i, broker := range plan.Brokers {
+		if broker.Exists {
+			masterAddrs[broker.BrokerName] = broker.BrokerAddr
+			continue
+		}
+
+		config := *sourceBrokers[i].config
+		config.GroupName = plan.TargetGroup
+		err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			return retryClient.CreateSubscriptionGroup(ctx, broker.BrokerAddr, config)
+		})
+		if err != nil {
+			failed[broker.BrokerName] = struct{}{}
+			result.FailedBrokers = append(result.FailedBrokers, broker.BrokerName)
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", broker.BrokerName, err))
+			continue
+		}
+		masterAddrs[broker.BrokerName] = broker.BrokerAddr
+		result.CreatedBrokers = append(result.CreatedBrokers, broker.BrokerName)
+	}
+
+	offsets := make([]model.QueueOffset, 0, len(plan.Offsets))
+	skipped := make([]model.OffsetUpdateResult, 0)
+	for _, item := range plan.Offsets {
+		if _, ok := failed[item.BrokerName]; ok {
+			skipped = append(skipped, model.OffsetUpdateResult{
+				Topic:      item.Topic,
+				BrokerName: item.BrokerName,
+				QueueID:    item.QueueID,
+				Offset:     item.NewOffset,
+				Error:      fmt.Sprintf("目标消费者组在 Broker %s 上创建失败，已跳过", item.BrokerName),
+			})
+			continue
+		}
+		offsets = append(offsets, model.QueueOffset{
+			Group:          plan.TargetGroup,
+			Topic:          item.Topic,
+			BrokerName:     item.BrokerName,
+			QueueID:        item.QueueID,
+			ConsumerOffset: item.NewOffset,
+		})
+	}
+	result.Offsets = append(updateQueueOffsets(client, plan.TargetGroup, offsets, masterAddrs), skipped...)
+
+	return result, nil
+}
+
+// buildGroupOffsetClonePlan 生成克隆预览，同时返回与 plan.Brokers 一一对应的源组 Broker 配置
+func buildGroupOffsetClonePlan(client *admin.Client, sourceGroup string, targetGroup string, topics []string) (*model.GroupOffsetClonePlan, []groupBrokerConfig, error) {
+	sourceGroup = strings.TrimSpace(sourceGroup)
+	targetGroup = strings.TrimSpace(targetGroup)
+	if sourceGroup == "" || targetGroup == "" {
+		return nil, nil, fmt.Errorf("克隆消费位点失败: 源消费者组和目标消费者组不能为空")
+	}
+	if sourceGroup == targetGroup {
+		return nil, nil, fmt.Errorf("克隆消费位点失败: 源消费者组和目标消费者组不能相同")
+	}
+
+	sourceConfigs, err := fetchGroupBrokerConfigs(client, sourceGroup)
+	if err != nil {
+		return nil, nil, fmt.Errorf("获取源消费者组配置失败: %w", err)
+	}
+	targetConfigs, err := fetchGroupBrokerConfigs(client, targetGroup)
+	if err != nil {
+		return nil, nil, fmt.Errorf("获取目标消费者组配置失败: %w", err)
+	}
+
+	targetExists := make(map[string]bool, len(targetConfigs))
+	for _, item := range targetConfigs {
+		targetExists[item.brokerName] = item.config != nil
+	}
+
+	plan := &model.GroupOffsetClonePlan{
+		SourceGroup: sourceGroup,
+		TargetGroup: targetGroup,
+		Brokers:     make([]model.GroupBrokerItem, 0),
+		Offsets:     make([]model.OffsetDiffItem, 0),
+	}
+	sourceBrokers := make([]groupBrokerConfig, 0)
+	for _, item := range sourceConfigs {
+		if item.config == nil {
+			continue
+		}
+		plan.Brokers = append(plan.Brokers, model.GroupBrokerItem{
+			BrokerName: item.brokerName,
+			BrokerAddr: item.brokerAddr,
+			Exists:     targetExists[item.brokerName],
+		})
+		sourceBrokers = append(sourceBrokers, item)
+	}
+	if len(plan.Brokers) == 0 {
+		return nil, nil, fmt.Errorf("克隆消费位点失败: 未在任何 Broker 上找到源消费者组 %s", sourceGroup)
+	}
+
+	sourceOffsets, err := fetchQueueOffsets(client, sourceGroup)
+	if err != nil {
+		return nil, nil, fmt.Errorf("获取源消费者组位点失败: %w", err)
+	}
+	targetOffsets, err := fetchQueueOffsets(client, targetGroup)
+	if err != nil {
+		// 目标消费者组尚未创建或从未消费时没有位点
+		targetOffsets = nil
+	}
+
+	currentOffsets := make(map[string]int64, len(targetOffsets))
+	for _, offset := range targetOffsets {
+		currentOffsets[queueOffsetKey(offset.Topic, offset.BrokerName, offset.QueueID)] = offset.ConsumerOffset
+	}
+
+	topicFilter := make(map[string]struct{}, len(topics))
+	for _, topic := range topics {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topicFilter[topic] = struct{}{}
+		}
+	}
+
+	for _, offset := range sourceOffsets {
+		if len(topicFilter) > 0 {
+			if _, ok := topicFilter[offset.Topic]; !ok {
+				continue
+			}
+		}
+
+		item := model.OffsetDiffItem{
+			Topic:      offset.Topic,
+			BrokerName: offset.BrokerName,
+			QueueID:    offset.QueueID,
+			BaseOffset: -1,
+			NewOffset:  offset.ConsumerOffset,
+		}
+		if current, ok := currentOffsets[queueOffsetKey(offset.Topic, offset.BrokerName, offset.QueueID)]; ok {
+			item.BaseOffset = current
+			item.Delta = item.NewOffset - current
+		}
+		plan.Offsets = append(plan.Offsets, item)
+	}
+
+	return plan, sourceBrokers, nil
+}
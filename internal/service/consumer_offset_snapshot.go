@@ -0,0 +1,309 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+)
+
+const (
+	offsetSnapshotDirName = "offset-snapshots"
+	offsetSnapshotVersion = 1
+	offsetSnapshotFileExt = ".json"
+)
+
+// CreateOffsetSnapshot 采集消费者组当前的全部消费位点并保存为本地快照
+func (s *ConsumerService) CreateOffsetSnapshot(group string, remark string) (*model.OffsetSnapshotInfo, error) {
+	group = strings.TrimSpace(group)
+	if group == "" {
+		return nil, fmt.Errorf("创建位点快照失败: 消费者组名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	offsets, err := fetchQueueOffsets(client, group)
+	if err != nil {
+		return nil, fmt.Errorf("获取消费位点失败: %w", err)
+	}
+	if len(offsets) == 0 {
+		return nil, fmt.Errorf("创建位点快照失败: 消费者组 %s 没有任何消费位点", group)
+	}
+
+	return s.saveOffsetSnapshot(group, model.SnapshotManual, remark, offsets)
+}
+
+// saveOffsetSnapshot 将已采集的消费位点保存为本地快照
+func (s *ConsumerService) saveOffsetSnapshot(group string, source model.OffsetSnapshotSource, remark string, offsets []model.QueueOffset) (*model.OffsetSnapshotInfo, error) {
+	now := time.Now()
+	snapshot := &model.OffsetSnapshot{
+		Version:    offsetSnapshotVersion,
+		ID:         fmt.Sprintf("%s-%d", sanitizeFileName(group), now.UnixMilli()),
+		Group:      group,
+		NameServer: rocketmq.GetClientManager().GetDefaultConnection(),
+		CreatedAt:  now.Format("2006-01-02 15:04:05"),
+		Timestamp:  now.UnixMilli(),
+		Source:     source,
+		Remark:     remark,
+		Offsets:    offsets,
+	}
+
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
+	if err := writeJSONFile(s.snapshotFilePath(snapshot.ID), snapshot); err != nil {
+		return nil, fmt.Errorf("保存位点快照失败: %w", err)
+	}
+
+	return snapshotInfo(snapshot), nil
+}
+
+// ListOffsetSnapshots 列出本地位点快照，group 为空时列出全部
+func (s *ConsumerService) ListOffsetSnapshots(group string) ([]*model.OffsetSnapshotInfo, error) {
+	group = strings.TrimSpace(group)
+
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
+	entries, err := os.ReadDir(s.snapshotDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*model.OffsetSnapshotInfo{}, nil
+		}
+		return nil, fmt.Errorf("读取位点快照目录失败: %w", err)
+	}
+
+	result := make([]*model.OffsetSnapshotInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != offsetSnapshotFileExt {
+			continue
+		}
+
+		snapshot, err := s.loadSnapshotLocked(strings.TrimSuffix(entry.Name(), offsetSnapshotFileExt))
+		if err != nil {
+			continue
+		}
+		if group != "" && snapshot.Group != group {
+			continue
+		}
+
+		result = append(result, snapshotInfo(snapshot))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Timestamp > result[j].Timestamp
+	})
+
+	return result, nil
+}
+
+// GetOffsetSnapshot 获取位点快照详情
+func (s *ConsumerService) GetOffsetSnapshot(snapshotID string) (*model.OffsetSnapshot, error) {
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
+	return s.loadSnapshotLocked(snapshotID)
+}
+
+// DeleteOffsetSnapshot 删除位点快照
+func (s *ConsumerService) DeleteOffsetSnapshot(snapshotID string) error {
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
+	if _, err := s.loadSnapshotLocked(snapshotID); err != nil {
+		return err
+	}
+
+	if err := os.Remove(s.snapshotFilePath(snapshotID)); err != nil {
+		return fmt.Errorf("删除位点快照失败: %w", err)
+	}
+
+	return nil
+}
+
+// DiffOffsetSnapshot 对比两个位点快照，targetID 为空时与消费者组当前位点对比
+func (s *ConsumerService) DiffOffsetSnapshot(baseID string, targetID string) ([]model.OffsetDiffItem, error) {
+	base, err := s.GetOffsetSnapshot(baseID)
+	if err != nil {
+		return nil, err
+	}
+
+	var targetOffsets []model.QueueOffset
+	if strings.TrimSpace(targetID) == "" {
+		client, err := rocketmq.GetClientManager().GetDefaultClient()
+		if err != nil {
+			return nil, fmt.Errorf("获取客户端失败: %w", err)
+		}
+
+		targetOffsets, err = fetchQueueOffsets(client, base.Group)
+		if err != nil {
+			return nil, fmt.Errorf("获取消费位点失败: %w", err)
+		}
+	} else {
+		target, err := s.GetOffsetSnapshot(targetID)
+		if err != nil {
+			return nil, err
+		}
+		targetOffsets = target.Offsets
+	}
+
+	return diffQueueOffsets(base.Offsets, targetOffsets), nil
+}
+
+// RestoreOffsetSnapshot 将位点快照写回 Broker
+// targetGroup 为空时恢复到快照所属消费者组，否则写入指定消费者组（可用于跨集群迁移）。
+// 消费者组有在线客户端时，客户端提交的位点会覆盖恢复结果，因此默认拒绝执行；force 为 true 时忽略该检查，恢复结果可能不生效。
+func (s *ConsumerService) RestoreOffsetSnapshot(snapshotID string, targetGroup string, force bool) ([]model.OffsetUpdateResult, error) {
+	snapshot, err := s.GetOffsetSnapshot(snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetGroup = strings.TrimSpace(targetGroup)
+	if targetGroup == "" {
+		targetGroup = snapshot.Group
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	if !force {
+		online, err := countOnlineClients(client, targetGroup)
+		if err != nil {
+			return nil, fmt.Errorf("恢复位点快照失败: 无法确认消费者组 %s 是否有在线客户端，请稍后重试或强制执行: %w", targetGroup, err)
+		}
+		if online > 0 {
+			return nil, fmt.Errorf("恢复位点快照失败: 消费者组 %s 仍有 %d 个在线客户端，请先停止消费或强制执行", targetGroup, online)
+		}
+	}
+
+	masterAddrs, err := fetchMasterAddrs(client)
+	if err != nil {
+		return nil, fmt.Errorf("获取集群信息失败: %w", err)
+	}
+
+	return updateQueueOffsets(client, targetGroup, snapshot.Offsets, masterAddrs), nil
+}
+
+func (s *ConsumerService) snapshotFilePath(snapshotID string) string {
+	return filepath.Join(s.snapshotDir, sanitizeFileName(snapshotID)+offsetSnapshotFileExt)
+}
+
+func (s *ConsumerService) loadSnapshotLocked(snapshotID string) (*model.OffsetSnapshot, error) {
+	snapshotID = strings.TrimSpace(snapshotID)
+	if snapshotID == "" {
+		return nil, fmt.Errorf("位点快照ID不能为空")
+	}
+
+	var snapshot model.OffsetSnapshot
+	found, err := readJSONFile(s.snapshotFilePath(snapshotID), &snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("读取位点快照失败: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("位点快照不存在: %s", snapshotID)
+	}
+	if snapshot.Version <= 0 || snapshot.Version > offsetSnapshotVersion {
+		return nil, fmt.Errorf("不支持的位点快照版本: %d", snapshot.Version)
+	}
+
+	return &snapshot, nil
+}
+
+func snapshotInfo(snapshot *model.OffsetSnapshot) *model.OffsetSnapshotInfo {
+	topics := make([]string, 0)
+	seenTopics := make(map[string]struct{})
+	for _, offset := range snapshot.Offsets {
+		if _, exists := seenTopics[offset.Topic]; exists {
+			continue
+		}
+		seenTopics[offset.Topic] = struct{}{}
+		topics = append(topics, offset.Topic)
+	}
+	sort.Strings(topics)
+
+	source := snapshot.Source
+	if source == "" {
+		source = model.SnapshotManual
+	}
+
+	return &model.OffsetSnapshotInfo{
+		ID:         snapshot.ID,
+		Group:      snapshot.Group,
+		NameServer: snapshot.NameServer,
+		CreatedAt:  snapshot.CreatedAt,
+		Timestamp:  snapshot.Timestamp,
+		Source:     source,
+		Remark:     snapshot.Remark,
+		Topics:     topics,
+		QueueCount: len(snapshot.Offsets),
+	}
+}
+
+// diffQueueOffsets 对比两组队列位点，仅返回存在差异的队列
+func diffQueueOffsets(base []model.QueueOffset, target []model.QueueOffset) []model.OffsetDiffItem {
+	merged := make(map[string]*model.OffsetDiffItem)
+	keys := make([]string, 0)
+
+	for _, offset := range base {
+		key := queueOffsetKey(offset.Topic, offset.BrokerName, offset.QueueID)
+		merged[key] = &model.OffsetDiffItem{
+			Topic:      offset.Topic,
+			BrokerName: offset.BrokerName,
+			QueueID:    offset.QueueID,
+			BaseOffset: offset.ConsumerOffset,
+			NewOffset:  -1,
+		}
+		keys = append(keys, key)
+	}
+
+	for _, offset := range target {
+		key := queueOffsetKey(offset.Topic, offset.BrokerName, offset.QueueID)
+		item, exists := merged[key]
+		if !exists {
+			item = &model.OffsetDiffItem{
+				Topic:      offset.Topic,
+				BrokerName: offset.BrokerName,
+				QueueID:    offset.QueueID,
+				BaseOffset: -1,
+			}
+			merged[key] = item
+			keys = append(keys, key)
+		}
+		item.NewOffset = offset.ConsumerOffset
+	}
+
+	result := make([]model.OffsetDiffItem, 0)
+	for _, key := range keys {
+		item := merged[key]
+		if item.BaseOffset == item.NewOffset {
+			continue
+		}
+		if item.BaseOffset >= 0 && item.NewOffset >= 0 {
+			item.Delta = item.NewOffset - item.BaseOffset
+		}
+		result = append(result, *item)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Topic != result[j].Topic {
+			return result[i].Topic < result[j].Topic
+		}
+		if result[i].BrokerName != result[j].BrokerName {
+			return result[i].BrokerName < result[j].BrokerName
+		}
+		return result[i].QueueID < result[j].QueueID
+	})
+
+	return result
+}
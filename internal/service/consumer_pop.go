@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const (
+	// Broker 处理 Pop 消息超时重投所用的系统消费者组与 Topic 前缀（RocketMQ 5.x）
+	popReviveGroup       = "CID_RMQ_SYS_REVIVE_GROUP"
+	popReviveTopicPrefix = "rmq_sys_REVIVE_LOG_"
+
+	// Broker 端 MessageRequestMode 取值
+	messageRequestModePull = "PULL"
+	messageRequestModePop  = "POP"
+
+	defaultPopShareQueueNum = -1 // 与 Broker 默认值一致，表示所有客户端共享全部队列
+)
+
+// GetPopConsumeStats 获取消费者组的 Pop 消费统计
+// 不可见消息数为已 Pop 位点与已确认位点之差；Revive 进度为 Broker 处理 Pop 超时重投的进度，按集群汇总。
+func (s *ConsumerService) GetPopConsumeStats(groupName string) (*model.PopConsumeStats, error) {
+	groupName = strings.TrimSpace(groupName)
+	if groupName == "" {
+		return nil, fmt.Errorf("获取 Pop 消费统计失败: 消费者组名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	result := &model.PopConsumeStats{
+		Group:       groupName,
+		ConsumeType: model.ConsumeTypeUnknown,
+		Queues:      make([]model.PopQueueStats, 0),
+		Revive:      make([]model.ReviveQueueStats, 0),
+		QueriedAt:   formatNow(),
+	}
+	if connInfo, err := fetchConsumerConnection(client, groupName); err == nil && connInfo != nil && len(connInfo.ConnectionSet) > 0 {
+		result.ConsumeType = consumeTypeOf(connInfo.ConsumeType)
+	}
+
+	var stats *admin.ConsumeStats
+	err = executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		consumeStats, callErr := retryClient.ExamineConsumeStats(ctx, groupName)
+		if callErr != nil {
+			return callErr
+		}
+		stats = consumeStats
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取消费统计失败: %w", err)
+	}
+
+	for mq, offset := range stats.OffsetTable {
+		if offset == nil {
+			continue
+		}
+
+		item := model.PopQueueStats{
+			Topic:        mq.Topic,
+			BrokerName:   mq.BrokerName,
+			QueueID:      mq.QueueId,
+			BrokerOffset: offset.BrokerOffset,
+			PopOffset:    offset.PullOffset,
+			AckOffset:    offset.ConsumerOffset,
+			Backlog:      offset.BrokerOffset - offset.ConsumerOffset,
+		}
+		if item.PopOffset > item.AckOffset {
+			item.Invisible = item.PopOffset - item.AckOffset
+		}
+		if item.Backlog < 0 {
+			item.Backlog = 0
+		}
+
+		result.TotalInvisible += item.Invisible
+		result.TotalBacklog += item.Backlog
+		result.Queues = append(result.Queues, item)
+	}
+	sort.Slice(result.Queues, func(i, j int) bool {
+		a, b := result.Queues[i], result.Queues[j]
+		if a.Topic != b.Topic {
+			return a.Topic < b.Topic
+		}
+		if a.BrokerName != b.BrokerName {
+			return a.BrokerName < b.BrokerName
+		}
+		return a.QueueID < b.QueueID
+	})
+
+	revive, err := fetchReviveStats(client)
+	if err != nil {
+		result.ReviveError = err.Error()
+	} else {
+		result.Revive = revive
+	}
+
+	return result, nil
+}
+
+// SwitchConsumeType 在 Broker 上切换消费者组的 Pop/Push 消费方式
+// 通过设置 Broker 端 MessageRequestMode 实现，仅 RocketMQ 5.x Broker 支持，低版本 Broker 的错误会在结果中返回。
+func (s *ConsumerService) SwitchConsumeType(req model.SwitchConsumeTypeRequest) ([]model.SwitchConsumeTypeResult, error) {
+	group := strings.TrimSpace(req.Group)
+	if group == "" {
+		return nil, fmt.Errorf("切换消费方式失败: 消费者组名称不能为空")
+	}
+
+	var mode string
+	switch req.ConsumeType {
+	case model.ConsumeTypePop:
+		mode = messageRequestModePop
+	case model.ConsumeTypePush:
+		mode = messageRequestModePull
+	default:
+		return nil, fmt.Errorf("切换消费方式失败: 不支持的消费类型 %s", req.ConsumeType)
+	}
+	if err := checkProtectionConfirm("切换消费方式", req.Confirm, group); err != nil {
+		return nil, err
+	}
+
+	popShareQueueNum := req.PopShareQueueNum
+	if popShareQueueNum <= 0 {
+		popShareQueueNum = defaultPopShareQueueNum
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	topics := make([]string, 0)
+	if topic := strings.TrimSpace(req.Topic); topic != "" {
+		topics = append(topics, topic)
+	} else {
+		offsets, err := fetchQueueOffsets(client, group)
+		if err != nil {
+			return nil, fmt.Errorf("获取消费位点失败: %w", err)
+		}
+		seen := make(map[string]struct{})
+		for _, offset := range offsets {
+			if strings.HasPrefix(offset.Topic, retryTopicPrefix) {
+				continue
+			}
+			if _, ok := seen[offset.Topic]; !ok {
+				seen[offset.Topic] = struct{}{}
+				topics = append(topics, offset.Topic)
+			}
+		}
+		if len(topics) == 0 {
+			return nil, fmt.Errorf("切换消费方式失败: 消费者组 %s 没有订阅任何 Topic", group)
+		}
+	}
+
+	results := make([]model.SwitchConsumeTypeResult, 0)
+	for _, topic := range topics {
+		queues, err := fetchTopicQueues(client, topic)
+		if err != nil {
+			return nil, fmt.Errorf("获取 Topic %s 路由失败: %w", topic, err)
+		}
+
+		brokers := make(map[string]string)
+		for _, queue := range queues {
+			brokers[queue.mq.BrokerName] = queue.brokerAddr
+		}
+
+		for _, brokerName := range sortedKeys(brokers) {
+			brokerAddr := brokers[brokerName]
+			err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				return retryClient.SetMessageRequestMode(ctx, brokerAddr, topic, group, mode, popShareQueueNum)
+			})
+
+			result := model.SwitchConsumeTypeResult{
+				Topic:      topic,
+				BrokerName: brokerName,
+				BrokerAddr: brokerAddr,
+				Success:    err == nil,
+			}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+// fetchReviveStats 获取各 Broker Revive 队列的处理进度
+func fetchReviveStats(client *admin.Client) ([]model.ReviveQueueStats, error) {
+	var stats *admin.ConsumeStats
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		consumeStats, callErr := retryClient.ExamineConsumeStats(ctx, popReviveGroup)
+		if callErr != nil {
+			return callErr
+		}
+		stats = consumeStats
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.ReviveQueueStats, 0, len(stats.OffsetTable))
+	for mq, offset := range stats.OffsetTable {
+		if offset == nil || !strings.HasPrefix(mq.Topic, popReviveTopicPrefix) {
+			continue
+		}
+
+		item := model.ReviveQueueStats{
+			BrokerName:   mq.BrokerName,
+			QueueID:      mq.QueueId,
+			ReviveOffset: offset.ConsumerOffset,
+			MaxOffset:    offset.BrokerOffset,
+			Pending:      offset.BrokerOffset - offset.ConsumerOffset,
+		}
+		if item.Pending < 0 {
+			item.Pending = 0
+		}
+		result = append(result, item)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].BrokerName != result[j].BrokerName {
+			return result[i].BrokerName < result[j].BrokerName
+		}
+		return result[i].QueueID < result[j].QueueID
+	})
+
+	return result, nil
+}
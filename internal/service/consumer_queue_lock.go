@@ -0,0 +1,385 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// brokerLockMaxLiveTime Broker 端队列锁有效期（rocketmq.broker.rebalance.lockMaxLiveTime 默认值）
+const brokerLockMaxLiveTime = 60 * time.Second
+
+// queueLockHolder 客户端上报的队列锁
+type queueLockHolder struct {
+	clientID     string
+	lastLockTime int64
+}
+
+// queueLockObservation 在线客户端上报的处理队列，key 为 queueOffsetKey
+type queueLockObservation struct {
+	holders  map[string]queueLockHolder // 已加锁的队列
+	assigned map[string]string          // 已分配给客户端但尚未加锁的队列 -> 客户端ID
+}
+
+// GetQueueLocks 获取消费者组各队列的顺序消费锁状态
+// 锁的持有者与续锁时间来自在线客户端上报的处理队列；probe 为 true 时，对没有分配给任何在线客户端的队列
+// 以临时客户端ID向 Broker 尝试加锁并立即释放，以区分空闲队列和被已下线客户端残留锁住的队列。
+// 已分配给在线客户端但尚未加锁的队列不会探测，避免探测锁阻塞客户端重平衡时加锁。
+// 探测会真实占用 Broker 端的锁，释放失败时顺序消费者在锁过期前无法加锁，因此保护模式下探测需输入消费者组名称确认。
+func (s *ConsumerService) GetQueueLocks(groupName string, probe bool, confirm string) (*model.QueueLockResult, error) {
+	groupName = strings.TrimSpace(groupName)
+	if groupName == "" {
+		return nil, fmt.Errorf("获取队列锁失败: 消费者组名称不能为空")
+	}
+	if probe {
+		if err := checkProtectionConfirm("探测队列锁", confirm, groupName); err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	offsets, err := fetchQueueOffsets(client, groupName)
+	if err != nil {
+		return nil, fmt.Errorf("获取消费位点失败: %w", err)
+	}
+
+	result := &model.QueueLockResult{
+		Group:     groupName,
+		Probed:    probe,
+		Queues:    make([]model.QueueLockItem, 0, len(offsets)),
+		CheckedAt: formatNow(),
+	}
+	if brokerConfigs, err := fetchGroupBrokerConfigs(client, groupName); err == nil {
+		for _, item := range brokerConfigs {
+			if item.config != nil {
+				result.Orderly = item.config.ConsumeMessageOrderly
+				break
+			}
+		}
+	}
+
+	observation := collectQueueLockObservation(client, groupName)
+	s.rememberLockHolders(groupName, observation.holders)
+
+	now := time.Now()
+	unassigned := make([]int, 0)
+	for _, offset := range offsets {
+		if strings.HasPrefix(offset.Topic, retryTopicPrefix) {
+			continue
+		}
+
+		key := queueOffsetKey(offset.Topic, offset.BrokerName, offset.QueueID)
+		item := model.QueueLockItem{
+			Topic:        offset.Topic,
+			BrokerName:   offset.BrokerName,
+			QueueID:      offset.QueueID,
+			State:        model.LockUnknown,
+			LastLockTime: "-",
+			ExpireTime:   "-",
+			Lag:          offset.BrokerOffset - offset.ConsumerOffset,
+		}
+		if item.Lag < 0 {
+			item.Lag = 0
+		}
+
+		if holder, ok := observation.holders[key]; ok {
+			expireAt := time.UnixMilli(holder.lastLockTime).Add(brokerLockMaxLiveTime)
+			item.State = model.LockHeld
+			item.HolderClientID = holder.clientID
+			item.LastLockTime = formatTimestamp(holder.lastLockTime)
+			item.ExpireTime = expireAt.Format("2006-01-02 15:04:05")
+			item.Expired = now.After(expireAt)
+		} else if clientID, ok := observation.assigned[key]; ok {
+			item.State = model.LockPending
+			item.HolderClientID = clientID
+		} else {
+			item.HolderClientID = s.lastLockHolder(groupName, key)
+			unassigned = append(unassigned, len(result.Queues))
+		}
+		result.Queues = append(result.Queues, item)
+	}
+
+	if probe && len(unassigned) > 0 {
+		if err := probeQueueLocks(client, groupName, result.Queues, unassigned); err != nil {
+			return nil, fmt.Errorf("探测 Broker 端队列锁失败: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// UnlockQueues 强制释放队列在 Broker 端的顺序消费锁
+// Broker 只释放由指定客户端ID持有的锁。未指定 ClientID 时依次使用在线客户端上报的持有者、
+// 最近一次检查时观察到的持有者；仍未知时探测 Broker 端是否存在锁并说明原因。保护模式下需输入消费者组名称确认。
+func (s *ConsumerService) UnlockQueues(req model.UnlockQueuesRequest) ([]model.QueueUnlockResult, error) {
+	group := strings.TrimSpace(req.Group)
+	if group == "" {
+		return nil, fmt.Errorf("强制解锁队列失败: 消费者组名称不能为空")
+	}
+	if len(req.Queues) == 0 {
+		return nil, fmt.Errorf("强制解锁队列失败: 未选择队列")
+	}
+	if err := checkProtectionConfirm("强制解锁队列", req.Confirm, group); err != nil {
+		return nil, err
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+	masterAddrs, err := fetchMasterAddrs(client)
+	if err != nil {
+		return nil, fmt.Errorf("获取 Broker 列表失败: %w", err)
+	}
+
+	observation := collectQueueLockObservation(client, group)
+	s.rememberLockHolders(group, observation.holders)
+
+	results := make([]model.QueueUnlockResult, 0, len(req.Queues))
+	for _, target := range req.Queues {
+		result := model.QueueUnlockResult{
+			Topic:      target.Topic,
+			BrokerName: target.BrokerName,
+			QueueID:    target.QueueID,
+			ClientID:   strings.TrimSpace(target.ClientID),
+		}
+		mq := admin.MessageQueue{
+			Topic:      target.Topic,
+			BrokerName: target.BrokerName,
+			QueueId:    target.QueueID,
+		}
+		key := queueOffsetKey(mq.Topic, mq.BrokerName, mq.QueueId)
+
+		brokerAddr, ok := masterAddrs[target.BrokerName]
+		if !ok {
+			result.Error = fmt.Sprintf("Broker 不存在或无 Master: %s", target.BrokerName)
+			results = append(results, result)
+			continue
+		}
+
+		// 持有者来自历史记录时可能已变化，解锁后需要探测确认
+		verify := false
+		if result.ClientID == "" {
+			if holder, ok := observation.holders[key]; ok {
+				result.ClientID = holder.clientID
+			} else if clientID := s.lastLockHolder(group, key); clientID != "" {
+				result.ClientID = clientID
+				verify = true
+			}
+		}
+
+		if result.ClientID == "" {
+			if _, assigned := observation.assigned[key]; assigned {
+				result.Error = "队列已分配给在线客户端但尚未加锁，无需解锁"
+				results = append(results, result)
+				continue
+			}
+			free, err := probeBrokerLocks(client, brokerAddr, group, []admin.MessageQueue{mq})
+			switch {
+			case err != nil:
+				result.Error = fmt.Sprintf("探测 Broker 端锁失败: %v", err)
+			case len(free) > 0:
+				result.Success = true
+				result.Message = "Broker 端没有有效的锁（未加锁或已过期），无需解锁"
+			default:
+				result.Error = fmt.Sprintf("锁持有者未知，Broker 只允许持有者释放锁；持有者停止续锁后锁会在 %d 秒内自动过期",
+					int(brokerLockMaxLiveTime/time.Second))
+			}
+			results = append(results, result)
+			continue
+		}
+
+		err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			return retryClient.UnlockBatchMQ(ctx, brokerAddr, group, result.ClientID, []admin.MessageQueue{mq})
+		})
+		if err != nil {
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+
+		if !verify {
+			result.Success = true
+			results = append(results, result)
+			continue
+		}
+
+		free, err := probeBrokerLocks(client, brokerAddr, group, []admin.MessageQueue{mq})
+		switch {
+		case err != nil:
+			result.Error = fmt.Sprintf("已按最近观察到的持有者解锁，但确认锁状态失败: %v", err)
+		case len(free) > 0:
+			result.Success = true
+			result.Message = "已按最近观察到的持有者释放锁"
+		default:
+			result.Error = fmt.Sprintf("按最近观察到的持有者 %s 解锁后锁仍存在，持有者可能已变化；持有者停止续锁后锁会在 %d 秒内自动过期",
+				result.ClientID, int(brokerLockMaxLiveTime/time.Second))
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// collectQueueLockObservation 从在线客户端的运行时信息中收集队列的加锁与分配情况
+func collectQueueLockObservation(client *admin.Client, group string) queueLockObservation {
+	observation := queueLockObservation{
+		holders:  make(map[string]queueLockHolder),
+		assigned: make(map[string]string),
+	}
+
+	connInfo, err := fetchConsumerConnection(client, group)
+	if err != nil || connInfo == nil {
+		return observation
+	}
+	for _, conn := range connInfo.ConnectionSet {
+		runningInfo, err := fetchConsumerRunningInfo(client, group, conn.ClientId, false)
+		if err != nil {
+			continue
+		}
+		for mq, pq := range runningInfo.MqTable {
+			if pq == nil || pq.Droped {
+				continue
+			}
+			key := queueOffsetKey(mq.Topic, mq.BrokerName, mq.QueueId)
+			if pq.Locked {
+				observation.holders[key] = queueLockHolder{
+					clientID:     conn.ClientId,
+					lastLockTime: pq.LastLockTimestamp,
+				}
+			} else {
+				observation.assigned[key] = conn.ClientId
+			}
+		}
+	}
+	for key := range observation.holders {
+		delete(observation.assigned, key)
+	}
+
+	return observation
+}
+
+// rememberLockHolders 记录观察到的持锁客户端，客户端下线后仍可用于释放其残留的锁
+func (s *ConsumerService) rememberLockHolders(group string, holders map[string]queueLockHolder) {
+	if len(holders) == 0 {
+		return
+	}
+
+	s.lockHoldersMu.Lock()
+	defer s.lockHoldersMu.Unlock()
+
+	groupHolders, ok := s.lockHolders[group]
+	if !ok {
+		groupHolders = make(map[string]string)
+		s.lockHolders[group] = groupHolders
+	}
+	for key, holder := range holders {
+		groupHolders[key] = holder.clientID
+	}
+}
+
+// lastLockHolder 获取最近一次观察到的队列持锁客户端，未观察到时返回空字符串
+func (s *ConsumerService) lastLockHolder(group string, key string) string {
+	s.lockHoldersMu.Lock()
+	defer s.lockHoldersMu.Unlock()
+
+	return s.lockHolders[group][key]
+}
+
+// probeQueueLocks 按 Broker 分批探测队列锁，加锁成功的队列为空闲，失败的队列被其他客户端持有
+func probeQueueLocks(client *admin.Client, group string, queues []model.QueueLockItem, indexes []int) error {
+	masterAddrs, err := fetchMasterAddrs(client)
+	if err != nil {
+		return err
+	}
+
+	brokerQueues := make(map[string][]int)
+	for _, index := range indexes {
+		brokerQueues[queues[index].BrokerName] = append(brokerQueues[queues[index].BrokerName], index)
+	}
+
+	for _, brokerName := range sortedKeys(brokerQueues) {
+		brokerAddr, ok := masterAddrs[brokerName]
+		if !ok {
+			continue
+		}
+
+		mqs := make([]admin.MessageQueue, 0, len(brokerQueues[brokerName]))
+		for _, index := range brokerQueues[brokerName] {
+			mqs = append(mqs, admin.MessageQueue{
+				Topic:      queues[index].Topic,
+				BrokerName: brokerName,
+				QueueId:    queues[index].QueueID,
+			})
+		}
+
+		free, err := probeBrokerLocks(client, brokerAddr, group, mqs)
+		if err != nil {
+			return fmt.Errorf("Broker %s: %w", brokerName, err)
+		}
+		for _, index := range brokerQueues[brokerName] {
+			item := &queues[index]
+			if _, ok := free[queueOffsetKey(item.Topic, item.BrokerName, item.QueueID)]; ok {
+				item.State = model.LockFree
+				item.HolderClientID = ""
+			} else {
+				item.State = model.LockOrphan
+			}
+		}
+	}
+
+	return nil
+}
+
+// probeBrokerLocks 以临时客户端ID尝试加锁并立即释放，返回加锁成功（即未被其他客户端持有）的队列
+// Broker 没有查询锁的接口，只能通过加锁结果判断；探测锁在同一次调用内释放，释放失败时最多保留一个锁有效期。
+func probeBrokerLocks(client *admin.Client, brokerAddr string, group string, mqs []admin.MessageQueue) (map[string]struct{}, error) {
+	probeClientID := fmt.Sprintf("rocket-leaf-lock-probe@%d", os.Getpid())
+
+	var locked []admin.MessageQueue
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		lockedQueues, callErr := retryClient.LockBatchMQ(ctx, brokerAddr, group, probeClientID, mqs)
+		if callErr != nil {
+			return callErr
+		}
+		locked = lockedQueues
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(locked) == 0 {
+		return map[string]struct{}{}, nil
+	}
+
+	err = executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return retryClient.UnlockBatchMQ(ctx, brokerAddr, group, probeClientID, locked)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("释放探测锁失败: %w", err)
+	}
+
+	free := make(map[string]struct{}, len(locked))
+	for _, mq := range locked {
+		free[queueOffsetKey(mq.Topic, mq.BrokerName, mq.QueueId)] = struct{}{}
+	}
+	return free, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// 客户端上报的 ConsumerRunningInfo 属性键
+const (
+	propThreadPoolCoreSize     = "PROP_THREADPOOL_CORE_SIZE"
+	propConsumeOrderly         = "PROP_CONSUMEORDERLY"
+	propConsumeType            = "PROP_CONSUME_TYPE"
+	propClientVersion          = "PROP_CLIENT_VERSION"
+	propConsumerStartTimestamp = "PROP_CONSUMER_START_TIMESTAMP"
+	propMessageModel           = "messageModel"
+	propConsumeThreadMin       = "consumeThreadMin"
+	propConsumeThreadMax       = "consumeThreadMax"
+)
+
+// GetConsumerRunningInfo 获取消费者客户端运行时信息
+// withJstack 为 true 时请求客户端返回线程栈，客户端不支持时 Jstack 为空。
+func (s *ConsumerService) GetConsumerRunningInfo(groupName string, clientID string, withJstack bool) (*model.ConsumerRunningDetail, error) {
+	groupName = strings.TrimSpace(groupName)
+	clientID = strings.TrimSpace(clientID)
+	if groupName == "" || clientID == "" {
+		return nil, fmt.Errorf("获取客户端运行信息失败: 消费者组和客户端ID不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	info, err := fetchConsumerRunningInfo(client, groupName, clientID, withJstack)
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端运行信息失败: %w", err)
+	}
+
+	return buildConsumerRunningDetail(groupName, clientID, info), nil
+}
+
+func buildConsumerRunningDetail(groupName string, clientID string, info *admin.ConsumerRunningInfo) *model.ConsumerRunningDetail {
+	props := info.Properties
+	if props == nil {
+		props = make(map[string]string)
+	}
+
+	detail := &model.ConsumerRunningDetail{
+		Group:          groupName,
+		ClientID:       clientID,
+		ConsumeType:    props[propConsumeType],
+		MessageModel:   props[propMessageModel],
+		ConsumeOrderly: strings.EqualFold(props[propConsumeOrderly], "true"),
+		ThreadPoolCore: parseIntSafe(props[propThreadPoolCoreSize]),
+		ThreadPoolMin:  parseIntSafe(props[propConsumeThreadMin]),
+		ThreadPoolMax:  parseIntSafe(props[propConsumeThreadMax]),
+		ClientVersion:  props[propClientVersion],
+		StartTime:      "-",
+		Subscriptions:  make([]model.GroupSubscription, 0),
+		ProcessQueues:  make([]model.ProcessQueueItem, 0),
+		TopicStatus:    make([]model.TopicConsumeStatus, 0),
+		Properties:     props,
+		Jstack:         info.Jstack,
+		QueriedAt:      formatNow(),
+	}
+
+	if startTimestamp, err := strconv.ParseInt(props[propConsumerStartTimestamp], 10, 64); err == nil {
+		detail.StartTime = formatTimestamp(startTimestamp)
+	}
+
+	for _, data := range info.SubscriptionSet {
+		if data == nil {
+			continue
+		}
+		detail.Subscriptions = append(detail.Subscriptions, model.GroupSubscription{
+			Topic:          data.Topic,
+			Expression:     data.SubString,
+			ExpressionType: normalizeExpressionType(data.ExpressionType),
+		})
+	}
+	sort.Slice(detail.Subscriptions, func(i, j int) bool {
+		return detail.Subscriptions[i].Topic < detail.Subscriptions[j].Topic
+	})
+
+	for mq, pq := range info.MqTable {
+		if pq == nil {
+			continue
+		}
+		detail.ProcessQueues = append(detail.ProcessQueues, model.ProcessQueueItem{
+			Topic:              mq.Topic,
+			BrokerName:         mq.BrokerName,
+			QueueID:            mq.QueueId,
+			CommitOffset:       pq.CommitOffset,
+			CachedMsgCount:     pq.CachedMsgCount,
+			CachedMsgSizeInMiB: pq.CachedMsgSizeInMiB,
+			CachedMsgMinOffset: pq.CachedMsgMinOffset,
+			CachedMsgMaxOffset: pq.CachedMsgMaxOffset,
+			Locked:             pq.Locked,
+			LastLockTime:       formatTimestamp(pq.LastLockTimestamp),
+			Dropped:            pq.Droped,
+			LastPullTime:       formatTimestamp(pq.LastPullTimestamp),
+			LastConsumeTime:    formatTimestamp(pq.LastConsumeTimestamp),
+		})
+	}
+	sort.Slice(detail.ProcessQueues, func(i, j int) bool {
+		a, b := detail.ProcessQueues[i], detail.ProcessQueues[j]
+		if a.Topic != b.Topic {
+			return a.Topic < b.Topic
+		}
+		if a.BrokerName != b.BrokerName {
+			return a.BrokerName < b.BrokerName
+		}
+		return a.QueueID < b.QueueID
+	})
+
+	for topic, status := range info.StatusTable {
+		if status == nil {
+			continue
+		}
+		detail.TopicStatus = append(detail.TopicStatus, model.TopicConsumeStatus{
+			Topic:             topic,
+			PullTps:           status.PullTPS,
+			PullRT:            status.PullRT,
+			ConsumeOKTps:      status.ConsumeOKTPS,
+			ConsumeFailedTps:  status.ConsumeFailedTPS,
+			ConsumeRT:         status.ConsumeRT,
+			ConsumeFailedMsgs: status.ConsumeFailedMsgs,
+		})
+	}
+	sort.Slice(detail.TopicStatus, func(i, j int) bool {
+		return detail.TopicStatus[i].Topic < detail.TopicStatus[j].Topic
+	})
+
+	return detail
+}
@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -14,13 +17,24 @@ import (
 
 // ConsumerService 消费者组服务
 type ConsumerService struct {
-	nextID int64
+	nextID      int64
+	snapshotMu  sync.Mutex // 保护位点快照文件读写
+	snapshotDir string     // 位点快照存储目录
+
+	detectorsMu sync.Mutex                // 保护 detectors
+	detectors   map[string]*stuckDetector // 消费者组 -> 卡住消费检测器
+
+	lockHoldersMu sync.Mutex                   // 保护 lockHolders
+	lockHolders   map[string]map[string]string // 消费者组 -> 队列 -> 最近一次观察到的持锁客户端ID
 }
 
 // NewConsumerService 创建消费者组服务
 func NewConsumerService() *ConsumerService {
 	return &ConsumerService{
-		nextID: 1,
+		nextID:      1,
+		snapshotDir: resolveAppDataDir(offsetSnapshotDirName),
+		detectors:   make(map[string]*stuckDetector),
+		lockHolders: make(map[string]map[string]string),
 	}
 }
 
@@ -78,10 +92,14 @@ func (s *ConsumerService) GetConsumerGroups() ([]*model.ConsumerGroupItem, error
 				ID:          s.getNextID(),
 				Group:       groupName,
 				ConsumeMode: model.ModeClustering,
+				ConsumeType: model.ConsumeTypeUnknown,
 				Status:      model.GroupOffline,
 				MaxRetry:    config.RetryMaxTimes,
 				LastUpdate:  formatNow(),
 			}
+			if config.ConsumeBroadcastEnable {
+				item.ConsumeMode = model.ModeBroadcasting
+			}
 
 			connInfo, err := client.ExamineConsumerConnectionInfo(ctx, groupName)
 			if err == nil && connInfo != nil {
@@ -90,20 +108,14 @@ func (s *ConsumerService) GetConsumerGroups() ([]*model.ConsumerGroupItem, error
 					item.Status = model.GroupOnline
 				}
 
-				for _, conn := range connInfo.ConnectionSet {
-					c := model.GroupClient{
-						ClientID:      conn.ClientId,
-						IP:            conn.ClientAddr,
-						Version:       fmt.Sprintf("%d", conn.Version),
-						LastHeartbeat: formatNow(),
-					}
-					item.Clients = append(item.Clients, c)
-				}
+				applyConsumeType(item, connInfo)
+				applyGroupClients(item, connInfo)
 
 				for topic, expr := range connInfo.SubscriptionTable {
 					sub := model.GroupSubscription{
-						Topic:      topic,
-						Expression: expr.SubString,
+						Topic:          topic,
+						Expression:     expr.SubString,
+						ExpressionType: expr.ExpressionType,
 					}
 					item.Subscriptions = append(item.Subscriptions, sub)
 				}
@@ -131,6 +143,7 @@ func (s *ConsumerService) GetConsumerGroupDetail(groupName string) (*model.Consu
 		ID:            s.getNextID(),
 		Group:         groupName,
 		ConsumeMode:   model.ModeClustering,
+		ConsumeType:   model.ConsumeTypeUnknown,
 		Status:        model.GroupOffline,
 		Subscriptions: make([]model.GroupSubscription, 0),
 		Clients:       make([]model.GroupClient, 0),
@@ -144,24 +157,14 @@ func (s *ConsumerService) GetConsumerGroupDetail(groupName string) (*model.Consu
 			item.Status = model.GroupOnline
 		}
 
-		if connInfo.ConsumeType == "CONSUME_ACTIVELY" {
-			item.ConsumeMode = model.ModeBroadcasting
-		}
-
-		for _, conn := range connInfo.ConnectionSet {
-			c := model.GroupClient{
-				ClientID:      conn.ClientId,
-				IP:            conn.ClientAddr,
-				Version:       fmt.Sprintf("%d", conn.Version),
-				LastHeartbeat: formatNow(),
-			}
-			item.Clients = append(item.Clients, c)
-		}
+		applyConsumeType(item, connInfo)
+		applyGroupClients(item, connInfo)
 
 		for topic, expr := range connInfo.SubscriptionTable {
 			sub := model.GroupSubscription{
-				Topic:      topic,
-				Expression: expr.SubString,
+				Topic:          topic,
+				Expression:     expr.SubString,
+				ExpressionType: expr.ExpressionType,
 			}
 			item.Subscriptions = append(item.Subscriptions, sub)
 		}
@@ -274,6 +277,130 @@ func (s *ConsumerService) GetConsumerClients(groupName string) ([]model.GroupCli
 	return detail.Clients, nil
 }
 
+// fetchConsumerConnection 获取消费者组的在线连接信息
+func fetchConsumerConnection(client *admin.Client, group string) (*admin.ConsumerConnection, error) {
+	var result *admin.ConsumerConnection
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		info, callErr := retryClient.ExamineConsumerConnectionInfo(ctx, group)
+		if callErr != nil {
+			return callErr
+		}
+		result = info
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// applyConsumeType 根据在线客户端上报的信息设置消费类型与消息模式
+// ConsumeType 表示 Push/Pull/Pop 消费方式，MessageModel 才表示集群/广播模式。
+func applyConsumeType(item *model.ConsumerGroupItem, connInfo *admin.ConsumerConnection) {
+	if len(connInfo.ConnectionSet) == 0 {
+		return
+	}
+
+	item.ConsumeType = consumeTypeOf(connInfo.ConsumeType)
+	switch strings.ToUpper(connInfo.MessageModel) {
+	case string(model.ModeBroadcasting):
+		item.ConsumeMode = model.ModeBroadcasting
+	case string(model.ModeClustering):
+		item.ConsumeMode = model.ModeClustering
+	}
+}
+
+// consumeTypeOf 将 Broker 返回的 ConsumeType 转换为消费类型
+func consumeTypeOf(consumeType string) model.ConsumeType {
+	switch strings.ToUpper(strings.TrimSpace(consumeType)) {
+	case "CONSUME_PASSIVELY":
+		return model.ConsumeTypePush
+	case "CONSUME_ACTIVELY":
+		return model.ConsumeTypePull
+	case "CONSUME_POP":
+		return model.ConsumeTypePop
+	default:
+		return model.ConsumeTypeUnknown
+	}
+}
+
+// applyGroupClients 填充客户端列表并标记过旧或混用的客户端版本
+// Broker 返回的连接信息与客户端运行时信息都不包含心跳时间，因此不填充 LastHeartbeat，
+// 只要客户端仍在列表中即表示其心跳未超时。
+func applyGroupClients(item *model.ConsumerGroupItem, connInfo *admin.ConsumerConnection) {
+	versions := make(rocketmq.ClientVersionSet)
+	for _, conn := range connInfo.ConnectionSet {
+		versionCode := int(conn.Version)
+		c := model.GroupClient{
+			ClientID:    conn.ClientId,
+			IP:          conn.ClientAddr,
+			Language:    rocketmq.LanguageDesc(conn.Language),
+			Version:     rocketmq.VersionDesc(versionCode),
+			VersionCode: versionCode,
+			Outdated:    rocketmq.IsOutdatedClient(conn.Language, versionCode),
+		}
+		if c.Outdated {
+			item.OutdatedCount++
+		}
+		versions.Add(conn.Language, versionCode)
+		item.Clients = append(item.Clients, c)
+	}
+
+	item.MixedVersions = versions.Mixed()
+}
+
+// groupBrokerConfig 消费者组在单个 Broker 上的订阅配置
+type groupBrokerConfig struct {
+	brokerName string
+	brokerAddr string
+	config     *admin.SubscriptionGroupConfig // 为 nil 表示该 Broker 上不存在该消费者组
+}
+
+// fetchGroupBrokerConfigs 获取所有 Master Broker 上指定消费者组的订阅配置，按 BrokerName 排序
+func fetchGroupBrokerConfigs(client *admin.Client, group string) ([]groupBrokerConfig, error) {
+	masterAddrs, err := fetchMasterAddrs(client)
+	if err != nil {
+		return nil, err
+	}
+
+	brokerNames := make([]string, 0, len(masterAddrs))
+	for brokerName := range masterAddrs {
+		brokerNames = append(brokerNames, brokerName)
+	}
+	sort.Strings(brokerNames)
+
+	result := make([]groupBrokerConfig, 0, len(brokerNames))
+	for _, brokerName := range brokerNames {
+		item := groupBrokerConfig{
+			brokerName: brokerName,
+			brokerAddr: masterAddrs[brokerName],
+		}
+
+		err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			subGroups, callErr := retryClient.GetAllSubscriptionGroup(ctx, item.brokerAddr)
+			if callErr != nil {
+				return callErr
+			}
+			item.config = subGroups[group]
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("获取 Broker %s 订阅组失败: %w", brokerName, err)
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
 // 判断是否为系统消费者组
 func isSystemGroup(group string) bool {
 	systemGroups := []string{
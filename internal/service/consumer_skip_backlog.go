@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// skipBacklogSnapshotRemark 跳过堆积前自动快照的备注，仅用于展示，可撤销的快照按 Source 识别
+const skipBacklogSnapshotRemark = "跳过堆积前自动快照"
+
+// PreviewSkipBacklog 预览跳过堆积的效果，topic 为空时包含消费者组订阅的全部 Topic（不含重试 Topic）
+func (s *ConsumerService) PreviewSkipBacklog(group string, topic string) (*model.SkipBacklogPlan, error) {
+	group = strings.TrimSpace(group)
+	if group == "" {
+		return nil, fmt.Errorf("预览跳过堆积失败: 消费者组名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	plan, _, err := buildSkipBacklogPlan(client, group, strings.TrimSpace(topic))
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// SkipBacklog 将消费者组的消费位点推进到队列最大位点，丢弃当前堆积
+// 执行前自动保存受影响队列的位点快照，可通过 UndoSkipBacklog 撤销。
+// 有在线客户端时默认拒绝执行；Force 为 true 时通过 Broker 通知客户端重置位点，避免被客户端提交的位点覆盖，
+// 此时撤销需要先停止所有客户端。
+func (s *ConsumerService) SkipBacklog(req model.SkipBacklogRequest) (*model.SkipBacklogResult, error) {
+	group := strings.TrimSpace(req.Group)
+	if group == "" {
+		return nil, fmt.Errorf("跳过堆积失败: 消费者组名称不能为空")
+	}
+	if err := checkProtectionConfirm("跳过堆积", req.Confirm, group); err != nil {
+		return nil, err
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	plan, offsets, err := buildSkipBacklogPlan(client, group, strings.TrimSpace(req.Topic))
+	if err != nil {
+		return nil, err
+	}
+	if plan.OnlineClients > 0 && !req.Force {
+		return nil, fmt.Errorf("跳过堆积失败: 消费者组 %s 仍有 %d 个在线客户端，请先停止消费或强制执行", group, plan.OnlineClients)
+	}
+
+	snapshot, err := s.saveOffsetSnapshot(group, model.SnapshotSkipBacklog, skipBacklogSnapshotRemark, offsets)
+	if err != nil {
+		return nil, fmt.Errorf("跳过堆积失败: %w", err)
+	}
+
+	result := &model.SkipBacklogResult{
+		Group:      group,
+		SnapshotID: snapshot.ID,
+	}
+
+	if plan.OnlineClients > 0 {
+		result.Offsets = resetTopicsToLatest(client, group, plan)
+	} else {
+		masterAddrs, err := fetchMasterAddrs(client)
+		if err != nil {
+			return nil, fmt.Errorf("获取集群信息失败: %w", err)
+		}
+
+		targets := make([]model.QueueOffset, 0, len(plan.Queues))
+		for _, item := range plan.Queues {
+			targets = append(targets, model.QueueOffset{
+				Group:          group,
+				Topic:          item.Topic,
+				BrokerName:     item.BrokerName,
+				QueueID:        item.QueueID,
+				ConsumerOffset: item.MaxOffset,
+			})
+		}
+		result.Offsets = updateQueueOffsets(client, group, targets, masterAddrs)
+	}
+
+	skipped := make(map[string]int64, len(plan.Queues))
+	for _, item := range plan.Queues {
+		skipped[queueOffsetKey(item.Topic, item.BrokerName, item.QueueID)] = item.Skipped
+	}
+	for _, offset := range result.Offsets {
+		if offset.Success {
+			result.TotalSkipped += skipped[queueOffsetKey(offset.Topic, offset.BrokerName, offset.QueueID)]
+		}
+	}
+
+	return result, nil
+}
+
+// UndoSkipBacklog 将消费者组位点恢复到跳过堆积前的自动快照
+// Broker 通知客户端重置位点只支持按时间戳，无法恢复到快照中的精确位点，而直接写入的位点会被在线客户端
+// 用内存中的位点覆盖，因此只能在消费者组没有在线客户端时撤销。
+func (s *ConsumerService) UndoSkipBacklog(snapshotID string) ([]model.OffsetUpdateResult, error) {
+	snapshot, err := s.GetOffsetSnapshot(snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Source != model.SnapshotSkipBacklog {
+		return nil, fmt.Errorf("撤销跳过堆积失败: 快照 %s 不是跳过堆积前的自动快照", snapshot.ID)
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	online, err := countOnlineClients(client, snapshot.Group)
+	if err != nil {
+		return nil, fmt.Errorf("撤销跳过堆积失败: 无法确认消费者组 %s 是否有在线客户端，请稍后重试: %w", snapshot.Group, err)
+	}
+	if online > 0 {
+		return nil, fmt.Errorf("撤销跳过堆积失败: 消费者组 %s 仍有 %d 个在线客户端，在线时写入的位点会被客户端覆盖，请先停止所有客户端后再撤销", snapshot.Group, online)
+	}
+
+	masterAddrs, err := fetchMasterAddrs(client)
+	if err != nil {
+		return nil, fmt.Errorf("获取集群信息失败: %w", err)
+	}
+
+	return updateQueueOffsets(client, snapshot.Group, snapshot.Offsets, masterAddrs), nil
+}
+
+// buildSkipBacklogPlan 计算跳过堆积的各队列目标位点，同时返回受影响队列的当前位点（用于快照）
+func buildSkipBacklogPlan(client *admin.Client, group string, topic string) (*model.SkipBacklogPlan, []model.QueueOffset, error) {
+	offsets, err := fetchQueueOffsets(client, group)
+	if err != nil {
+		return nil, nil, fmt.Errorf("获取消费位点失败: %w", err)
+	}
+
+	online, err := countOnlineClients(client, group)
+	if err != nil {
+		return nil, nil, fmt.Errorf("获取在线客户端失败: %w", err)
+	}
+
+	plan := &model.SkipBacklogPlan{
+		Group:         group,
+		Topics:        make([]string, 0),
+		OnlineClients: online,
+		Queues:        make([]model.SkipBacklogItem, 0),
+	}
+	affected := make([]model.QueueOffset, 0)
+	topics := make(map[string]struct{})
+	for _, offset := range offsets {
+		if topic != "" && offset.Topic != topic {
+			continue
+		}
+		if topic == "" && strings.HasPrefix(offset.Topic, retryTopicPrefix) {
+			continue
+		}
+
+		item := model.SkipBacklogItem{
+			Topic:          offset.Topic,
+			BrokerName:     offset.BrokerName,
+			QueueID:        offset.QueueID,
+			ConsumerOffset: offset.ConsumerOffset,
+			MaxOffset:      offset.BrokerOffset,
+			Skipped:        offset.BrokerOffset - offset.ConsumerOffset,
+		}
+		if item.Skipped < 0 {
+			item.Skipped = 0
+		}
+
+		plan.Queues = append(plan.Queues, item)
+		plan.TotalSkipped += item.Skipped
+		affected = append(affected, offset)
+		topics[offset.Topic] = struct{}{}
+	}
+
+	if len(plan.Queues) == 0 {
+		if topic != "" {
+			return nil, nil, fmt.Errorf("消费者组 %s 在 Topic %s 上没有消费位点", group, topic)
+		}
+		return nil, nil, fmt.Errorf("消费者组 %s 没有任何消费位点", group)
+	}
+
+	for name := range topics {
+		plan.Topics = append(plan.Topics, name)
+	}
+	sort.Strings(plan.Topics)
+	return plan, affected, nil
+}
+
+// resetTopicsToLatest 按当前时间重置各 Topic 的位点，由 Broker 通知在线客户端生效
+// 只有 Broker 返回了新位点的队列视为成功，其余队列标记为失败。
+func resetTopicsToLatest(client *admin.Client, group string, plan *model.SkipBacklogPlan) []model.OffsetUpdateResult {
+	results := make([]model.OffsetUpdateResult, 0, len(plan.Queues))
+	for _, topic := range plan.Topics {
+		var resetOffsets map[admin.MessageQueue]int64
+		err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+
+			offsets, callErr := retryClient.ResetOffsetByTimestamp(ctx, topic, group, time.Now().UnixMilli(), true)
+			if callErr != nil {
+				return callErr
+			}
+			resetOffsets = offsets
+			return nil
+		})
+
+		for _, item := range plan.Queues {
+			if item.Topic != topic {
+				continue
+			}
+
+			result := model.OffsetUpdateResult{
+				Topic:      item.Topic,
+				BrokerName: item.BrokerName,
+				QueueID:    item.QueueID,
+				Offset:     item.MaxOffset,
+			}
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				mq := admin.MessageQueue{Topic: item.Topic, BrokerName: item.BrokerName, QueueId: item.QueueID}
+				if offset, ok := resetOffsets[mq]; ok {
+					result.Offset = offset
+					result.Success = true
+				} else {
+					result.Error = "Broker 未返回该队列的重置位点，位点可能未更新"
+				}
+			}
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
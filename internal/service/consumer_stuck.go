@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+)
+
+const (
+	defaultStuckWindowSeconds   = 300
+	defaultStuckIntervalSeconds = 30
+	minStuckIntervalSeconds     = 5
+	stuckFindingType            = "stuckQueue"
+	stuckDetectionErrorType     = "stuckDetectionError"
+)
+
+// queueSample 队列位点的采样状态
+type queueSample struct {
+	offset        model.QueueOffset
+	lastChangedAt time.Time
+	reported      bool // 是否已推送过卡住事件，位点推进后重置
+}
+
+// stuckDetector 单个消费者组的卡住消费检测器
+type stuckDetector struct {
+	group    string
+	window   time.Duration
+	interval time.Duration
+	cancel   context.CancelFunc
+
+	mu           sync.Mutex
+	samples      map[string]*queueSample
+	sampleCount  int
+	lastSampleAt string
+	lastError    string
+	stuck        []model.StuckQueue
+}
+
+// StartStuckDetection 开始周期采样消费者组的消费位点，检测长时间未推进且有堆积的队列
+// 同一消费者组重复调用会以新参数重新开始检测。
+func (s *ConsumerService) StartStuckDetection(req model.StuckDetectionRequest) error {
+	group := strings.TrimSpace(req.Group)
+	if group == "" {
+		return fmt.Errorf("开始卡住消费检测失败: 消费者组名称不能为空")
+	}
+
+	windowSeconds := req.WindowSeconds
+	if windowSeconds <= 0 {
+		windowSeconds = defaultStuckWindowSeconds
+	}
+	intervalSeconds := req.IntervalSeconds
+	if intervalSeconds <= 0 {
+		intervalSeconds = defaultStuckIntervalSeconds
+	}
+	if intervalSeconds < minStuckIntervalSeconds {
+		intervalSeconds = minStuckIntervalSeconds
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	detector := &stuckDetector{
+		group:    group,
+		window:   time.Duration(windowSeconds) * time.Second,
+		interval: time.Duration(intervalSeconds) * time.Second,
+		cancel:   cancel,
+		samples:  make(map[string]*queueSample),
+		stuck:    make([]model.StuckQueue, 0),
+	}
+
+	s.detectorsMu.Lock()
+	if existing, ok := s.detectors[group]; ok {
+		existing.cancel()
+	}
+	s.detectors[group] = detector
+	s.detectorsMu.Unlock()
+
+	go detector.run(ctx)
+	return nil
+}
+
+// StopStuckDetection 停止消费者组的卡住消费检测
+func (s *ConsumerService) StopStuckDetection(group string) error {
+	group = strings.TrimSpace(group)
+
+	s.detectorsMu.Lock()
+	defer s.detectorsMu.Unlock()
+
+	detector, ok := s.detectors[group]
+	if !ok {
+		return fmt.Errorf("消费者组 %s 未开启卡住消费检测", group)
+	}
+	detector.cancel()
+	delete(s.detectors, group)
+	return nil
+}
+
+// GetStuckDetectionStatus 获取消费者组卡住消费检测的当前状态
+func (s *ConsumerService) GetStuckDetectionStatus(group string) (*model.StuckDetectionStatus, error) {
+	group = strings.TrimSpace(group)
+
+	s.detectorsMu.Lock()
+	detector, ok := s.detectors[group]
+	s.detectorsMu.Unlock()
+	if !ok {
+		return &model.StuckDetectionStatus{
+			Group:       group,
+			StuckQueues: make([]model.StuckQueue, 0),
+		}, nil
+	}
+
+	return detector.status(), nil
+}
+
+// GetGroupHealth 汇总消费者组健康检查结论
+// 状态与 GetConsumerGroups 一致，按是否有在线客户端判断在线或离线，在线且存在卡住队列时为告警。
+// 卡住队列的结论来自已开启的卡住消费检测，未开启时给出提示。
+func (s *ConsumerService) GetGroupHealth(group string) (*model.GroupHealth, error) {
+	group = strings.TrimSpace(group)
+	if group == "" {
+		return nil, fmt.Errorf("获取消费者组健康状况失败: 消费者组名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	online, err := countOnlineClients(client, group)
+	if err != nil {
+		return nil, fmt.Errorf("获取消费者组健康状况失败: 获取在线客户端失败: %w", err)
+	}
+
+	health := &model.GroupHealth{
+		Group:     group,
+		Status:    model.GroupOffline,
+		Findings:  make([]model.GroupHealthFinding, 0),
+		CheckedAt: formatNow(),
+	}
+	if online > 0 {
+		health.Status = model.GroupOnline
+	}
+
+	status, err := s.GetStuckDetectionStatus(group)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !status.Running:
+		health.Findings = append(health.Findings, model.GroupHealthFinding{
+			Level:   model.HealthInfo,
+			Type:    stuckFindingType,
+			Message: "未开启卡住消费检测",
+		})
+	case len(status.StuckQueues) > 0:
+		if online > 0 {
+			health.Status = model.GroupWarning
+		}
+		health.Findings = append(health.Findings, model.GroupHealthFinding{
+			Level: model.HealthCritical,
+			Type:  stuckFindingType,
+			Message: fmt.Sprintf("%d 个队列的消费位点已超过 %d 秒未推进且存在堆积",
+				len(status.StuckQueues), status.WindowSeconds),
+			StuckQueues: status.StuckQueues,
+		})
+	}
+	if status.Running && status.LastError != "" {
+		health.Findings = append(health.Findings, model.GroupHealthFinding{
+			Level:   model.HealthWarning,
+			Type:    stuckDetectionErrorType,
+			Message: fmt.Sprintf("最近一次位点采样失败: %s", status.LastError),
+		})
+	}
+
+	return health, nil
+}
+
+// ServiceShutdown 应用退出时停止所有后台检测
+func (s *ConsumerService) ServiceShutdown() error {
+	s.detectorsMu.Lock()
+	defer s.detectorsMu.Unlock()
+
+	for group, detector := range s.detectors {
+		detector.cancel()
+		delete(s.detectors, group)
+	}
+	return nil
+}
+
+func (d *stuckDetector) run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.sample()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sample 采样一次消费位点并更新卡住队列列表，新发现的卡住队列会推送事件
+func (d *stuckDetector) sample() {
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		d.recordError(fmt.Errorf("获取客户端失败: %w", err))
+		return
+	}
+
+	offsets, err := fetchQueueOffsets(client, d.group)
+	if err != nil {
+		d.recordError(fmt.Errorf("获取消费位点失败: %w", err))
+		return
+	}
+
+	now := time.Now()
+	d.mu.Lock()
+	present := make(map[string]struct{}, len(offsets))
+	candidates := make([]*queueSample, 0)
+	for _, offset := range offsets {
+		key := queueOffsetKey(offset.Topic, offset.BrokerName, offset.QueueID)
+		present[key] = struct{}{}
+
+		sample, ok := d.samples[key]
+		if !ok || sample.offset.ConsumerOffset != offset.ConsumerOffset {
+			d.samples[key] = &queueSample{offset: offset, lastChangedAt: now}
+			continue
+		}
+		sample.offset = offset
+
+		if offset.BrokerOffset > offset.ConsumerOffset && now.Sub(sample.lastChangedAt) >= d.window {
+			candidates = append(candidates, sample)
+		} else {
+			sample.reported = false
+		}
+	}
+	for key := range d.samples {
+		if _, ok := present[key]; !ok {
+			delete(d.samples, key)
+		}
+	}
+	d.mu.Unlock()
+
+	// 没有在线客户端时位点不推进属于离线而非卡住
+	var ownership *queueOwnership
+	if len(candidates) > 0 {
+		connInfo, err := fetchConsumerConnection(client, d.group)
+		if err != nil && !isConsumerNotOnlineError(err) {
+			d.recordError(fmt.Errorf("获取消费者连接信息失败: %w", err))
+			return
+		}
+		if connInfo == nil || len(connInfo.ConnectionSet) == 0 {
+			candidates = candidates[:0]
+		} else {
+			ownership = collectQueueOwnership(client, d.group, connInfo)
+		}
+	}
+
+	d.mu.Lock()
+	stuck := make([]model.StuckQueue, 0, len(candidates))
+	fresh := make([]model.StuckQueue, 0)
+	for _, sample := range candidates {
+		offset := sample.offset
+		queue := model.StuckQueue{
+			Topic:          offset.Topic,
+			BrokerName:     offset.BrokerName,
+			QueueID:        offset.QueueID,
+			ConsumerOffset: offset.ConsumerOffset,
+			BrokerOffset:   offset.BrokerOffset,
+			Lag:            offset.BrokerOffset - offset.ConsumerOffset,
+			StuckSince:     sample.lastChangedAt.Format("2006-01-02 15:04:05"),
+			StuckSeconds:   int64(now.Sub(sample.lastChangedAt).Seconds()),
+		}
+		if owners := ownership.owners[queueOffsetKey(offset.Topic, offset.BrokerName, offset.QueueID)]; len(owners) > 0 {
+			queue.ClientID = strings.Join(owners, ",")
+		}
+
+		stuck = append(stuck, queue)
+		if !sample.reported {
+			sample.reported = true
+			fresh = append(fresh, queue)
+		}
+	}
+	d.stuck = stuck
+	d.sampleCount++
+	d.lastSampleAt = formatNow()
+	d.lastError = ""
+	d.mu.Unlock()
+
+	if len(fresh) > 0 {
+		log.Printf("[ConsumerService] 消费者组 %s 发现 %d 个卡住的队列", d.group, len(fresh))
+		emitEvent(EventConsumerStuck, model.StuckConsumerEvent{
+			Group:      d.group,
+			Queues:     fresh,
+			DetectedAt: formatNow(),
+		})
+	}
+}
+
+func (d *stuckDetector) recordError(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.sampleCount++
+	d.lastSampleAt = formatNow()
+	d.lastError = err.Error()
+}
+
+func (d *stuckDetector) status() *model.StuckDetectionStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return &model.StuckDetectionStatus{
+		Group:           d.group,
+		Running:         true,
+		WindowSeconds:   int(d.window / time.Second),
+		IntervalSeconds: int(d.interval / time.Second),
+		Samples:         d.sampleCount,
+		LastSampleAt:    d.lastSampleAt,
+		LastError:       d.lastError,
+		StuckQueues:     append([]model.StuckQueue{}, d.stuck...),
+	}
+}
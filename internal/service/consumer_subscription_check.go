@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const retryTopicPrefix = "%RETRY%"
+
+// CheckSubscriptionConsistency 检查消费者组内各客户端的订阅关系是否一致
+// 逐个获取客户端上报的订阅数据，标记 Topic、过滤表达式、表达式类型不一致以及订阅了不存在 Topic 的情况。
+func (s *ConsumerService) CheckSubscriptionConsistency(groupName string) (*model.SubscriptionCheckResult, error) {
+	groupName = strings.TrimSpace(groupName)
+	if groupName == "" {
+		return nil, fmt.Errorf("检查订阅关系失败: 消费者组名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	connInfo, err := fetchConsumerConnection(client, groupName)
+	if err != nil {
+		return nil, fmt.Errorf("获取消费者连接信息失败: %w", err)
+	}
+
+	result := &model.SubscriptionCheckResult{
+		Group:     groupName,
+		Clients:   make([]model.ClientSubscription, 0),
+		Issues:    make([]model.SubscriptionIssue, 0),
+		CheckedAt: formatNow(),
+	}
+
+	// topic -> clientID -> 订阅关系
+	topicSubs := make(map[string]map[string]model.GroupSubscription)
+	reachableClients := make([]string, 0)
+
+	if connInfo != nil {
+		for _, conn := range connInfo.ConnectionSet {
+			clientSub := model.ClientSubscription{
+				ClientID:      conn.ClientId,
+				Subscriptions: make([]model.GroupSubscription, 0),
+			}
+
+			runningInfo, err := fetchConsumerRunningInfo(client, groupName, conn.ClientId, false)
+			if err != nil {
+				clientSub.Error = err.Error()
+				result.Clients = append(result.Clients, clientSub)
+				result.Issues = append(result.Issues, model.SubscriptionIssue{
+					Type:      model.IssueClientUnreachable,
+					Message:   fmt.Sprintf("无法获取客户端订阅信息: %v", err),
+					ClientIDs: []string{conn.ClientId},
+				})
+				continue
+			}
+
+			for _, data := range runningInfo.SubscriptionSet {
+				if data == nil || strings.HasPrefix(data.Topic, retryTopicPrefix) {
+					continue
+				}
+
+				sub := model.GroupSubscription{
+					Topic:          data.Topic,
+					Expression:     data.SubString,
+					ExpressionType: normalizeExpressionType(data.ExpressionType),
+				}
+				clientSub.Subscriptions = append(clientSub.Subscriptions, sub)
+
+				if topicSubs[sub.Topic] == nil {
+					topicSubs[sub.Topic] = make(map[string]model.GroupSubscription)
+				}
+				topicSubs[sub.Topic][conn.ClientId] = sub
+			}
+
+			sort.Slice(clientSub.Subscriptions, func(i, j int) bool {
+				return clientSub.Subscriptions[i].Topic < clientSub.Subscriptions[j].Topic
+			})
+			result.Clients = append(result.Clients, clientSub)
+			reachableClients = append(reachableClients, conn.ClientId)
+		}
+	}
+
+	existingTopics := make(map[string]struct{})
+	topicListErr := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		topicList, callErr := retryClient.FetchAllTopicList(ctx)
+		if callErr != nil {
+			return callErr
+		}
+		for _, topic := range topicList.TopicList {
+			existingTopics[topic] = struct{}{}
+		}
+		return nil
+	})
+
+	topics := make([]string, 0, len(topicSubs))
+	for topic := range topicSubs {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	for _, topic := range topics {
+		subs := topicSubs[topic]
+
+		if topicListErr == nil {
+			if _, exists := existingTopics[topic]; !exists {
+				result.Issues = append(result.Issues, model.SubscriptionIssue{
+					Type:      model.IssueTopicNotFound,
+					Topic:     topic,
+					Message:   fmt.Sprintf("订阅的 Topic %s 不存在", topic),
+					ClientIDs: sortedClientIDs(subs),
+				})
+			}
+		}
+
+		missing := make([]string, 0)
+		for _, clientID := range reachableClients {
+			if _, ok := subs[clientID]; !ok {
+				missing = append(missing, clientID)
+			}
+		}
+		if len(missing) > 0 {
+			result.Issues = append(result.Issues, model.SubscriptionIssue{
+				Type:      model.IssueTopicMismatch,
+				Topic:     topic,
+				Message:   fmt.Sprintf("%d 个客户端未订阅 Topic %s", len(missing), topic),
+				ClientIDs: missing,
+			})
+		}
+
+		expressions := make(map[string][]string)
+		expressionTypes := make(map[string][]string)
+		for clientID, sub := range subs {
+			expressions[sub.Expression] = append(expressions[sub.Expression], clientID)
+			expressionTypes[sub.ExpressionType] = append(expressionTypes[sub.ExpressionType], clientID)
+		}
+		if len(expressionTypes) > 1 {
+			result.Issues = append(result.Issues, model.SubscriptionIssue{
+				Type:      model.IssueExpressionTypeMismatch,
+				Topic:     topic,
+				Message:   fmt.Sprintf("Topic %s 的表达式类型不一致: %s", topic, describeVariants(expressionTypes)),
+				ClientIDs: sortedClientIDs(subs),
+			})
+		}
+		if len(expressions) > 1 {
+			result.Issues = append(result.Issues, model.SubscriptionIssue{
+				Type:      model.IssueExpressionMismatch,
+				Topic:     topic,
+				Message:   fmt.Sprintf("Topic %s 的过滤表达式不一致: %s", topic, describeVariants(expressions)),
+				ClientIDs: sortedClientIDs(subs),
+			})
+		}
+	}
+
+	result.Consistent = len(result.Issues) == 0
+	return result, nil
+}
+
+// fetchConsumerRunningInfo 获取消费者客户端运行时信息
+func fetchConsumerRunningInfo(client *admin.Client, group string, clientID string, jstack bool) (*admin.ConsumerRunningInfo, error) {
+	var result *admin.ConsumerRunningInfo
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		info, callErr := retryClient.GetConsumerRunningInfo(ctx, group, clientID, jstack)
+		if callErr != nil {
+			return callErr
+		}
+		if info == nil {
+			return fmt.Errorf("客户端未返回运行时信息")
+		}
+		result = info
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// normalizeExpressionType 表达式类型为空时按 TAG 处理
+func normalizeExpressionType(expressionType string) string {
+	expressionType = strings.ToUpper(strings.TrimSpace(expressionType))
+	if expressionType == "" {
+		return "TAG"
+	}
+	return expressionType
+}
+
+func sortedClientIDs(subs map[string]model.GroupSubscription) []string {
+	clientIDs := make([]string, 0, len(subs))
+	for clientID := range subs {
+		clientIDs = append(clientIDs, clientID)
+	}
+	sort.Strings(clientIDs)
+	return clientIDs
+}
+
+// describeVariants 将“取值 -> 客户端列表”描述为可读文本
+func describeVariants(variants map[string][]string) string {
+	values := make([]string, 0, len(variants))
+	for value := range variants {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		parts = append(parts, fmt.Sprintf("%q(%d 个客户端)", value, len(variants[value])))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,23 @@
+package service
+
+import "github.com/wailsapp/wails/v3/pkg/application"
+
+// 推送给前端的事件名称
+const (
+	EventConsumerStuck     = "consumer:stuck"          // 检测到卡住的消费队列
+	EventMessageTail       = "message:tail"            // 实时跟踪到的新消息
+	EventMessageTailStatus = "message:tail:status"     // 实时跟踪状态变化（出错、停止）
+	EventSearchProgress    = "message:search:progress" // 消息体搜索进度
+	EventSearchMatch       = "message:search:match"    // 消息体搜索命中的消息
+	EventReplayProgress    = "message:replay:progress" // 消息回放进度
+	EventReplayItems       = "message:replay:items"    // 消息回放的逐条结果
+)
+
+// emitEvent 向前端推送事件，应用尚未启动时忽略
+func emitEvent(name string, data any) {
+	app := application.Get()
+	if app == nil {
+		return
+	}
+	app.Event.Emit(name, data)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// maxDecompressedBodySize 解压后消息体的最大字节数
+const maxDecompressedBodySize = 64 << 20
+
+// decompressBody 根据 sysFlag 解压消息体，返回解压后的内容与压缩算法名称
+// 支持 RocketMQ 的 ZLIB、LZ4（LZ4 Frame 格式）与 ZSTD 压缩，LZ4 与 ZSTD 会校验帧中携带的校验和；
+// 部分客户端在拉取时已完成解压，解压失败时原样返回消息体并返回错误。
+func decompressBody(body []byte, sysFlag int32) ([]byte, string, error) {
+	if sysFlag&sysFlagCompressed == 0 {
+		return body, "", nil
+	}
+
+	switch sysFlag & sysFlagCompressionMask {
+	case 0, sysFlagCompressionZLIB:
+		reader, err := zlib.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return body, "zlib", fmt.Errorf("zlib 解压失败: %w", err)
+		}
+		defer reader.Close()
+
+		data, err := readDecompressed(reader)
+		if err != nil {
+			return body, "zlib", fmt.Errorf("zlib 解压失败: %w", err)
+		}
+		return data, "zlib", nil
+	case sysFlagCompressionLZ4:
+		data, err := readDecompressed(lz4.NewReader(bytes.NewReader(body)))
+		if err != nil {
+			return body, "lz4", fmt.Errorf("lz4 解压失败: %w", err)
+		}
+		return data, "lz4", nil
+	case sysFlagCompressionZSTD:
+		decoder, err := zstd.NewReader(bytes.NewReader(body),
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxWindow(maxDecompressedBodySize),
+		)
+		if err != nil {
+			return body, "zstd", fmt.Errorf("zstd 解压失败: %w", err)
+		}
+		defer decoder.Close()
+
+		data, err := readDecompressed(decoder)
+		if err != nil {
+			return body, "zstd", fmt.Errorf("zstd 解压失败: %w", err)
+		}
+		return data, "zstd", nil
+	default:
+		return body, "unknown", fmt.Errorf("未知的压缩类型: %d", (sysFlag&sysFlagCompressionMask)>>8)
+	}
+}
+
+// readDecompressed 读取解压后的全部数据，超过 maxDecompressedBodySize 时返回错误，避免压缩炸弹耗尽内存
+func readDecompressed(reader io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, maxDecompressedBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxDecompressedBodySize {
+		return nil, fmt.Errorf("解压后超过 %d 字节", maxDecompressedBodySize)
+	}
+	return data, nil
+}
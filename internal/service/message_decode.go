@@ -0,0 +1,546 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const topicDecoderFileName = "topic-decoders.json"
+
+// 消息 sysFlag 中的压缩标记，与 RocketMQ MessageSysFlag 保持一致
+const (
+	sysFlagCompressed       int32 = 0x1
+	sysFlagCompressionMask  int32 = 0x7 << 8
+	sysFlagCompressionLZ4   int32 = 0x1 << 8
+	sysFlagCompressionZSTD  int32 = 0x2 << 8
+	sysFlagCompressionZLIB  int32 = 0x3 << 8
+	maxDecodedContentLength       = 1 << 20
+)
+
+// topicDecoderStore 按 Topic 记住的解码配置，以及编译后的 Protobuf 类型、Avro 编解码器缓存
+type topicDecoderStore struct {
+	mu         sync.RWMutex
+	path       string
+	loaded     bool
+	configs    map[string]model.DecoderConfig
+	protoTypes map[string]protoreflect.MessageDescriptor // key: 描述符文件路径 + 消息类型
+	avroCodecs map[string]*goavro.Codec                  // key: Schema 文本
+}
+
+func newTopicDecoderStore(path string) *topicDecoderStore {
+	return &topicDecoderStore{
+		path:       path,
+		configs:    make(map[string]model.DecoderConfig),
+		protoTypes: make(map[string]protoreflect.MessageDescriptor),
+		avroCodecs: make(map[string]*goavro.Codec),
+	}
+}
+
+// ensureLoaded 首次使用时从磁盘加载解码配置
+func (st *topicDecoderStore) ensureLoaded() error {
+	st.mu.RLock()
+	loaded := st.loaded
+	st.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.loaded {
+		return nil
+	}
+
+	configs := make([]model.DecoderConfig, 0)
+	if _, err := readJSONFile(st.path, &configs); err != nil {
+		return fmt.Errorf("读取解码配置失败: %w", err)
+	}
+	for _, config := range configs {
+		st.configs[config.Topic] = config
+	}
+	st.loaded = true
+	return nil
+}
+
+// get 获取 Topic 记住的解码配置
+func (st *topicDecoderStore) get(topic string) (model.DecoderConfig, bool, error) {
+	if err := st.ensureLoaded(); err != nil {
+		return model.DecoderConfig{}, false, err
+	}
+
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	config, ok := st.configs[topic]
+	return config, ok, nil
+}
+
+// put 保存 Topic 的解码配置，Decoder 为 auto 时删除记录
+func (st *topicDecoderStore) put(config model.DecoderConfig) error {
+	if err := st.ensureLoaded(); err != nil {
+		return err
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	previous, existed := st.configs[config.Topic]
+	if config.Decoder == model.DecoderAuto {
+		delete(st.configs, config.Topic)
+	} else {
+		st.configs[config.Topic] = config
+	}
+
+	configs := make([]model.DecoderConfig, 0, len(st.configs))
+	for _, topic := range sortedKeys(st.configs) {
+		configs = append(configs, st.configs[topic])
+	}
+	if err := writeJSONFile(st.path, configs); err != nil {
+		if existed {
+			st.configs[config.Topic] = previous
+		} else {
+			delete(st.configs, config.Topic)
+		}
+		return fmt.Errorf("保存解码配置失败: %w", err)
+	}
+	return nil
+}
+
+// protoType 加载描述符集合并查找消息类型，结果按文件路径与类型缓存
+func (st *topicDecoderStore) protoType(descriptorPath string, messageType string) (protoreflect.MessageDescriptor, error) {
+	cacheKey := descriptorPath + "#" + messageType
+	st.mu.RLock()
+	descriptor, ok := st.protoTypes[cacheKey]
+	st.mu.RUnlock()
+	if ok {
+		return descriptor, nil
+	}
+
+	data, err := os.ReadFile(descriptorPath)
+	if err != nil {
+		return nil, fmt.Errorf("读取描述符文件失败: %w", err)
+	}
+
+	fileSet := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, fileSet); err != nil {
+		return nil, fmt.Errorf("解析描述符集合失败: %w", err)
+	}
+	files, err := protodesc.NewFiles(fileSet)
+	if err != nil {
+		return nil, fmt.Errorf("构建描述符失败（生成时需加 --include_imports）: %w", err)
+	}
+
+	found, err := files.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(messageType, ".")))
+	if err != nil {
+		return nil, fmt.Errorf("描述符集合中不存在消息类型 %s", messageType)
+	}
+	descriptor, ok = found.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s 不是消息类型", messageType)
+	}
+
+	st.mu.Lock()
+	st.protoTypes[cacheKey] = descriptor
+	st.mu.Unlock()
+	return descriptor, nil
+}
+
+// avroCodec 编译 Avro Schema，结果按 Schema 文本缓存
+func (st *topicDecoderStore) avroCodec(schema string) (*goavro.Codec, error) {
+	st.mu.RLock()
+	codec, ok := st.avroCodecs[schema]
+	st.mu.RUnlock()
+	if ok {
+		return codec, nil
+	}
+
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		return nil, fmt.Errorf("解析 Avro Schema 失败: %w", err)
+	}
+
+	st.mu.Lock()
+	st.avroCodecs[schema] = codec
+	st.mu.Unlock()
+	return codec, nil
+}
+
+// invalidate 清除与配置相关的编译缓存，描述符文件可能已被替换
+func (st *topicDecoderStore) invalidate(config model.DecoderConfig) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	delete(st.protoTypes, config.ProtoDescriptor+"#"+config.ProtoMessageType)
+	delete(st.avroCodecs, config.AvroSchema)
+}
+
+// decode 按配置解码消息体
+func (st *topicDecoderStore) decode(body []byte, config model.DecoderConfig) (string, error) {
+	switch config.Decoder {
+	case model.DecoderProtobuf:
+		descriptor, err := st.protoType(config.ProtoDescriptor, config.ProtoMessageType)
+		if err != nil {
+			return "", err
+		}
+		message := dynamicpb.NewMessage(descriptor)
+		if err := proto.Unmarshal(body, message); err != nil {
+			return "", fmt.Errorf("Protobuf 解码失败: %w", err)
+		}
+		content, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(message)
+		if err != nil {
+			return "", fmt.Errorf("Protobuf 转换 JSON 失败: %w", err)
+		}
+		return string(content), nil
+	case model.DecoderAvro:
+		codec, err := st.avroCodec(config.AvroSchema)
+		if err != nil {
+			return "", err
+		}
+		return decodeAvroBody(codec, body)
+	default:
+		return decodeBuiltinBody(body, config.Decoder)
+	}
+}
+
+// decodeAvroBody 解码 Avro 二进制，兼容 Confluent Schema Registry 的 5 字节头（魔数 0 + Schema ID）
+func decodeAvroBody(codec *goavro.Codec, body []byte) (string, error) {
+	native, remaining, err := codec.NativeFromBinary(body)
+	if (err != nil || len(remaining) > 0) && len(body) > 5 && body[0] == 0 {
+		if framedNative, framedRemaining, framedErr := codec.NativeFromBinary(body[5:]); framedErr == nil && len(framedRemaining) == 0 {
+			native, remaining, err = framedNative, framedRemaining, nil
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("Avro 解码失败: %w", err)
+	}
+	if len(remaining) > 0 {
+		return "", fmt.Errorf("Avro 解码失败: 剩余 %d 字节未解析，Schema 可能不匹配", len(remaining))
+	}
+
+	textual, err := codec.TextualFromNative(nil, native)
+	if err != nil {
+		return "", fmt.Errorf("Avro 转换 JSON 失败: %w", err)
+	}
+	return prettyJSON(textual)
+}
+
+// decodeBuiltinBody 使用内置解码器解码消息体
+func decodeBuiltinBody(body []byte, decoder model.BodyDecoder) (string, error) {
+	switch decoder {
+	case model.DecoderText:
+		if !utf8.Valid(body) {
+			return "", fmt.Errorf("消息体不是合法的 UTF-8 文本")
+		}
+		return string(body), nil
+	case model.DecoderJSON:
+		return prettyJSON(body)
+	case model.DecoderXML:
+		return prettyXML(body)
+	case model.DecoderHex:
+		return hexDump(body), nil
+	case model.DecoderBase64:
+		return base64.StdEncoding.EncodeToString(body), nil
+	default:
+		return "", fmt.Errorf("不支持的解码器: %s", decoder)
+	}
+}
+
+// autoDecodeBody 自动识别消息体格式：JSON、XML、文本，均不满足时输出十六进制转储
+func autoDecodeBody(body []byte) (model.BodyDecoder, string) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		if content, err := prettyJSON(trimmed); err == nil {
+			return model.DecoderJSON, content
+		}
+	}
+	if len(trimmed) > 0 && trimmed[0] == '<' {
+		if content, err := prettyXML(trimmed); err == nil {
+			return model.DecoderXML, content
+		}
+	}
+	if isPrintableText(body) {
+		return model.DecoderText, string(body)
+	}
+	return model.DecoderHex, hexDump(body)
+}
+
+// prettyJSON 格式化 JSON
+func prettyJSON(data []byte) (string, error) {
+	var out bytes.Buffer
+	if err := json.Indent(&out, bytes.TrimSpace(data), "", "  "); err != nil {
+		return "", fmt.Errorf("JSON 解析失败: %w", err)
+	}
+	return out.String(), nil
+}
+
+// prettyXML 格式化 XML
+func prettyXML(data []byte) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var out bytes.Buffer
+	encoder := xml.NewEncoder(&out)
+	encoder.Indent("", "  ")
+
+	hasElement := false
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("XML 解析失败: %w", err)
+		}
+		switch typed := token.(type) {
+		case xml.StartElement:
+			hasElement = true
+		case xml.CharData:
+			// 丢弃元素间的空白，由编码器重新缩进
+			if len(bytes.TrimSpace(typed)) == 0 {
+				continue
+			}
+		}
+		if err := encoder.EncodeToken(xml.CopyToken(token)); err != nil {
+			return "", fmt.Errorf("XML 格式化失败: %w", err)
+		}
+	}
+	if !hasElement {
+		return "", fmt.Errorf("XML 解析失败: 未找到元素")
+	}
+	if err := encoder.Flush(); err != nil {
+		return "", fmt.Errorf("XML 格式化失败: %w", err)
+	}
+	return out.String(), nil
+}
+
+// hexDump 输出十六进制转储，超长时截断
+func hexDump(body []byte) string {
+	if len(body)*4 <= maxDecodedContentLength {
+		return hex.Dump(body)
+	}
+	limit := maxDecodedContentLength / 4
+	return hex.Dump(body[:limit]) + fmt.Sprintf("... 共 %d 字节，仅显示前 %d 字节\n", len(body), limit)
+}
+
+// isPrintableText 判断消息体是否为可读的 UTF-8 文本
+func isPrintableText(body []byte) bool {
+	if !utf8.Valid(body) {
+		return false
+	}
+	for _, r := range string(body) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeDecoderConfig 校验并规范化解码配置
+func normalizeDecoderConfig(config model.DecoderConfig) (model.DecoderConfig, error) {
+	config.Topic = strings.TrimSpace(config.Topic)
+	config.ProtoDescriptor = strings.TrimSpace(config.ProtoDescriptor)
+	config.ProtoMessageType = strings.TrimSpace(config.ProtoMessageType)
+	config.AvroSchema = strings.TrimSpace(config.AvroSchema)
+	if config.Decoder == "" {
+		config.Decoder = model.DecoderAuto
+	}
+
+	switch config.Decoder {
+	case model.DecoderAuto, model.DecoderText, model.DecoderJSON, model.DecoderXML, model.DecoderHex, model.DecoderBase64:
+	case model.DecoderProtobuf:
+		if config.ProtoDescriptor == "" || config.ProtoMessageType == "" {
+			return config, fmt.Errorf("Protobuf 解码需要描述符集合文件与消息类型")
+		}
+	case model.DecoderAvro:
+		if config.AvroSchema == "" {
+			return config, fmt.Errorf("Avro 解码需要 Schema")
+		}
+	default:
+		return config, fmt.Errorf("不支持的解码器: %s", config.Decoder)
+	}
+	return config, nil
+}
+
+// GetTopicDecoder 获取 Topic 记住的解码配置，未配置时返回 auto
+func (s *MessageService) GetTopicDecoder(topic string) (*model.DecoderConfig, error) {
+	topic = strings.TrimSpace(topic)
+	if topic == "" {
+		return nil, fmt.Errorf("获取解码配置失败: Topic 名称不能为空")
+	}
+
+	config, ok, err := s.decoders.get(topic)
+	if err != nil {
+		return nil, fmt.Errorf("获取解码配置失败: %w", err)
+	}
+	if !ok {
+		return &model.DecoderConfig{Topic: topic, Decoder: model.DecoderAuto}, nil
+	}
+	return &config, nil
+}
+
+// SetTopicDecoder 设置并记住 Topic 的解码器，Protobuf/Avro 配置会先编译校验
+func (s *MessageService) SetTopicDecoder(config model.DecoderConfig) (*model.DecoderConfig, error) {
+	config, err := normalizeDecoderConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("设置解码配置失败: %w", err)
+	}
+	if config.Topic == "" {
+		return nil, fmt.Errorf("设置解码配置失败: Topic 名称不能为空")
+	}
+
+	s.decoders.invalidate(config)
+	switch config.Decoder {
+	case model.DecoderProtobuf:
+		if _, err := s.decoders.protoType(config.ProtoDescriptor, config.ProtoMessageType); err != nil {
+			return nil, fmt.Errorf("设置解码配置失败: %w", err)
+		}
+	case model.DecoderAvro:
+		if _, err := s.decoders.avroCodec(config.AvroSchema); err != nil {
+			return nil, fmt.Errorf("设置解码配置失败: %w", err)
+		}
+	}
+
+	config.UpdatedAt = formatNow()
+	if err := s.decoders.put(config); err != nil {
+		return nil, fmt.Errorf("设置解码配置失败: %w", err)
+	}
+	return &config, nil
+}
+
+// DecodeMessageBody 使用指定或 Topic 记住的解码器解码消息体
+func (s *MessageService) DecodeMessageBody(req model.DecodeBodyRequest) (*model.DecodedBody, error) {
+	topic := strings.TrimSpace(req.Topic)
+	msgID := strings.TrimSpace(req.MsgID)
+	if topic == "" || msgID == "" {
+		return nil, fmt.Errorf("解码消息体失败: Topic 与消息ID不能为空")
+	}
+
+	config := model.DecoderConfig{Topic: topic, Decoder: model.DecoderAuto}
+	if req.Config != nil {
+		normalized, err := normalizeDecoderConfig(*req.Config)
+		if err != nil {
+			return nil, fmt.Errorf("解码消息体失败: %w", err)
+		}
+		config = normalized
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	var msg *admin.MessageExt
+	err = executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		found, callErr := retryClient.ViewMessage(ctx, topic, msgID)
+		if callErr != nil {
+			return callErr
+		}
+		msg = found
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("查询消息失败: %w", err)
+	}
+
+	if req.Config == nil {
+		if remembered, ok := s.rememberedDecoder(decoderTopicOf(msg)); ok {
+			config = remembered
+		}
+	}
+	return s.decodeMessageBody(msg, config), nil
+}
+
+// rememberedDecoder 获取 Topic 记住的解码配置，读取失败时按未配置处理
+func (s *MessageService) rememberedDecoder(topic string) (model.DecoderConfig, bool) {
+	config, ok, err := s.decoders.get(topic)
+	if err != nil {
+		log.Printf("[MessageService] 读取 Topic %s 解码配置失败: %v", topic, err)
+		return model.DecoderConfig{}, false
+	}
+	return config, ok
+}
+
+// decodeMessageBody 解压并解码消息体，指定解码器失败时回退为自动识别并记录错误
+func (s *MessageService) decodeMessageBody(msg *admin.MessageExt, config model.DecoderConfig) *model.DecodedBody {
+	body, compression, decompressErr := decompressBody(msg.Body, msg.SysFlag)
+	result := &model.DecodedBody{
+		Decoder:     config.Decoder,
+		Compression: compression,
+		Compressed:  decompressErr != nil,
+		Size:        len(body),
+		RawSize:     len(msg.Body),
+	}
+
+	var decodeErr error
+	if config.Decoder != model.DecoderAuto {
+		result.Content, decodeErr = s.decoders.decode(body, config)
+	}
+	if config.Decoder == model.DecoderAuto || decodeErr != nil {
+		result.Decoder, result.Content = autoDecodeBody(body)
+	}
+
+	errs := make([]string, 0, 2)
+	if decompressErr != nil {
+		errs = append(errs, decompressErr.Error())
+	}
+	if decodeErr != nil {
+		errs = append(errs, decodeErr.Error())
+	}
+	result.Error = strings.Join(errs, "; ")
+	return result
+}
+
+// decoderTopicOf 获取决定解码器的 Topic，重试/死信消息使用原始 Topic
+func decoderTopicOf(msg *admin.MessageExt) string {
+	if strings.HasPrefix(msg.Topic, retryTopicPrefix) || strings.HasPrefix(msg.Topic, dlqTopicPrefix) {
+		if originTopic := msg.Properties[propertyRetryTopic]; originTopic != "" {
+			return originTopic
+		}
+	}
+	return msg.Topic
+}
+
+// applyMessageBody 填充 MessageItem 的消息体字段：文本原样输出，二进制使用 base64
+// 只标记 Topic 记住的解码器，不在列表中逐条解码，解码结果由 DecodeMessageBody 按需获取。
+func (s *MessageService) applyMessageBody(item *model.MessageItem, msg *admin.MessageExt) {
+	body, compression, decompressErr := decompressBody(msg.Body, msg.SysFlag)
+	item.BodySize = len(body)
+	item.Compression = compression
+	item.Compressed = decompressErr != nil
+	if utf8.Valid(body) {
+		item.Body = string(body)
+		item.BodyEncoding = model.BodyEncodingText
+	} else {
+		item.Body = base64.StdEncoding.EncodeToString(body)
+		item.BodyEncoding = model.BodyEncodingBase64
+	}
+
+	if config, ok := s.rememberedDecoder(decoderTopicOf(msg)); ok {
+		item.Decoder = config.Decoder
+	}
+}
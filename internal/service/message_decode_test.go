@@ -0,0 +1,148 @@
+package service
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"rocket-leaf/internal/model"
+)
+
+// 以下 LZ4 与 ZSTD 数据由 lz4、zstd 命令行工具生成
+const (
+	lz4TextFrame         = "BCJNGGRApyQAAAD/B1JvY2tldE1RIG1lc3NhZ2UgYm9keSAWAP///0VQYm9keSAAAAAAVQzkPQ=="                 // 默认参数
+	lz4TextLinkedFrame   = "BCJNGHxAcAMAAAAAAADwJAAAAP8HUm9ja2V0TVEgbWVzc2FnZSBib2R5IBYA////RVBib2R5IHHbTwQAAAAAVQzkPQ==" // -BD --content-size -BX
+	lz4TinyFrame         = "BCJNGGRApwIAAIBoaQAAAABkpafa"                                                                 // 不可压缩，按原文块存储
+	lz4TinyNoCheckFrame  = "BCJNGGBAggIAAIBoaQAAAAA="                                                                     // --no-frame-crc
+	zstdTextFrame        = "KLUv/WRwAu0AALBSb2NrZXRNUSBtZXNzYWdlIGJvZHkgAQCuZjWbbFT/bQ=="                                 // --check
+	zstdTextNoCheckFrame = "KLUv/WBwAu0AALBSb2NrZXRNUSBtZXNzYWdlIGJvZHkgAQCuZjWb"                                         // --no-check
+)
+
+func mustBase64(t *testing.T, value string) []byte {
+	t.Helper()
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		t.Fatalf("base64 解码失败: %v", err)
+	}
+	return data
+}
+
+// corruptLastByte 返回翻转了最后一个字节的副本，用于构造校验和不匹配的数据
+func corruptLastByte(data []byte) []byte {
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-1] ^= 0xFF
+	return corrupted
+}
+
+func zlibCompress(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	writer := zlib.NewWriter(&out)
+	if _, err := writer.Write(data); err != nil {
+		t.Fatalf("zlib 压缩失败: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("zlib 压缩失败: %v", err)
+	}
+	return out.Bytes()
+}
+
+func TestDecompressBody(t *testing.T) {
+	text := []byte(strings.Repeat("RocketMQ message body ", 40))
+	skippable := append([]byte{0x50, 0x2A, 0x4D, 0x18, 0x03, 0x00, 0x00, 0x00, 1, 2, 3}, mustBase64(t, lz4TinyFrame)...)
+
+	tests := []struct {
+		name        string
+		body        []byte
+		sysFlag     int32
+		want        []byte
+		compression string
+		wantErr     bool
+	}{
+		{name: "未压缩", body: []byte("plain"), sysFlag: 0, want: []byte("plain")},
+		{name: "zlib", body: zlibCompress(t, text), sysFlag: sysFlagCompressed | sysFlagCompressionZLIB, want: text, compression: "zlib"},
+		{name: "旧版本未标记算法按 zlib", body: zlibCompress(t, text), sysFlag: sysFlagCompressed, want: text, compression: "zlib"},
+		{name: "lz4 默认参数", body: mustBase64(t, lz4TextFrame), sysFlag: sysFlagCompressed | sysFlagCompressionLZ4, want: text, compression: "lz4"},
+		{name: "lz4 链接块与校验", body: mustBase64(t, lz4TextLinkedFrame), sysFlag: sysFlagCompressed | sysFlagCompressionLZ4, want: text, compression: "lz4"},
+		{name: "lz4 原文块", body: mustBase64(t, lz4TinyFrame), sysFlag: sysFlagCompressed | sysFlagCompressionLZ4, want: []byte("hi"), compression: "lz4"},
+		{name: "lz4 无内容校验", body: mustBase64(t, lz4TinyNoCheckFrame), sysFlag: sysFlagCompressed | sysFlagCompressionLZ4, want: []byte("hi"), compression: "lz4"},
+		{
+			name:        "lz4 连续多帧",
+			body:        append(mustBase64(t, lz4TextFrame), mustBase64(t, lz4TinyFrame)...),
+			sysFlag:     sysFlagCompressed | sysFlagCompressionLZ4,
+			want:        append(append([]byte{}, text...), "hi"...),
+			compression: "lz4",
+		},
+		{name: "lz4 可跳过帧", body: skippable, sysFlag: sysFlagCompressed | sysFlagCompressionLZ4, want: []byte("hi"), compression: "lz4"},
+		{name: "lz4 截断", body: mustBase64(t, lz4TextFrame)[:20], sysFlag: sysFlagCompressed | sysFlagCompressionLZ4, compression: "lz4", wantErr: true},
+		{name: "lz4 内容校验和不匹配", body: corruptLastByte(mustBase64(t, lz4TextFrame)), sysFlag: sysFlagCompressed | sysFlagCompressionLZ4, compression: "lz4", wantErr: true},
+		{name: "lz4 魔数错误", body: []byte("not lz4 data"), sysFlag: sysFlagCompressed | sysFlagCompressionLZ4, compression: "lz4", wantErr: true},
+		{name: "zlib 超过大小上限", body: zlibCompress(t, make([]byte, maxDecompressedBodySize+1)), sysFlag: sysFlagCompressed | sysFlagCompressionZLIB, compression: "zlib", wantErr: true},
+		{name: "zlib 数据损坏", body: []byte("broken"), sysFlag: sysFlagCompressed | sysFlagCompressionZLIB, compression: "zlib", wantErr: true},
+		{name: "zstd", body: mustBase64(t, zstdTextFrame), sysFlag: sysFlagCompressed | sysFlagCompressionZSTD, want: text, compression: "zstd"},
+		{name: "zstd 无校验和", body: mustBase64(t, zstdTextNoCheckFrame), sysFlag: sysFlagCompressed | sysFlagCompressionZSTD, want: text, compression: "zstd"},
+		{name: "zstd 校验和不匹配", body: corruptLastByte(mustBase64(t, zstdTextFrame)), sysFlag: sysFlagCompressed | sysFlagCompressionZSTD, compression: "zstd", wantErr: true},
+		{name: "zstd 截断", body: mustBase64(t, zstdTextFrame)[:10], sysFlag: sysFlagCompressed | sysFlagCompressionZSTD, compression: "zstd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, compression, err := decompressBody(tt.body, tt.sysFlag)
+			if compression != tt.compression {
+				t.Errorf("压缩算法 = %q, 期望 %q", compression, tt.compression)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("期望返回错误")
+				}
+				if !bytes.Equal(got, tt.body) {
+					t.Errorf("出错时应原样返回消息体")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("解压失败: %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("解压结果 = %q, 期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoDecodeBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    []byte
+		decoder model.BodyDecoder
+		content string
+	}{
+		{name: "JSON 对象", body: []byte(` {"a":1,"b":[true]} `), decoder: model.DecoderJSON, content: "{\n  \"a\": 1,\n  \"b\": [\n    true\n  ]\n}"},
+		{name: "JSON 数组", body: []byte(`[1,2]`), decoder: model.DecoderJSON, content: "[\n  1,\n  2\n]"},
+		{name: "不合法的 JSON 按文本", body: []byte(`{"a":`), decoder: model.DecoderText, content: `{"a":`},
+		{name: "XML", body: []byte(`<a><b>1</b></a>`), decoder: model.DecoderXML, content: "<a>\n  <b>1</b>\n</a>"},
+		{name: "不合法的 XML 按文本", body: []byte(`<a><b></a>`), decoder: model.DecoderText, content: `<a><b></a>`},
+		{name: "文本", body: []byte("hello 世界\n"), decoder: model.DecoderText, content: "hello 世界\n"},
+		{name: "二进制", body: []byte{0x00, 0x01, 0xFF}, decoder: model.DecoderHex},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoder, content := autoDecodeBody(tt.body)
+			if decoder != tt.decoder {
+				t.Fatalf("解码器 = %q, 期望 %q", decoder, tt.decoder)
+			}
+			if tt.decoder == model.DecoderHex {
+				if content != hexDump(tt.body) {
+					t.Errorf("十六进制转储 = %q, 期望 %q", content, hexDump(tt.body))
+				}
+				return
+			}
+			if content != tt.content {
+				t.Errorf("内容 = %q, 期望 %q", content, tt.content)
+			}
+		})
+	}
+}
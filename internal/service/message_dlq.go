@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	rmq "github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const (
+	redeliveryRecordDirName    = "dlq-redelivery"
+	defaultDLQMaxResults       = 200
+	maxDLQMaxResults           = 5000
+	defaultDLQScanLimit        = 10000
+	maxDLQScanLimit            = 100000
+	defaultRedeliverRatePerSec = 20
+	maxRedeliverRatePerSec     = 1000
+)
+
+// 重投时不复制的系统属性，由 Broker 或生产者重新生成
+var redeliverDroppedProperties = map[string]struct{}{
+	propertyUniqKey:        {},
+	"MIN_OFFSET":           {},
+	"MAX_OFFSET":           {},
+	"CONSUME_START_TIME":   {},
+	"REAL_TOPIC":           {},
+	"REAL_QID":             {},
+	"DELAY":                {},
+	"TRAN_MSG":             {},
+	"PGROUP":               {},
+	"__STARTDELIVERTIME":   {},
+	"TIMER_DELIVER_MS":     {},
+	"TIMER_DELAY_SEC":      {},
+	"TIMER_DELAY_MS":       {},
+	"TIMER_OUT_MS":         {},
+	"CLUSTER":              {},
+	"TRACE_ON":             {},
+	"MSG_REGION":           {},
+	"INNER_MULTI_DISPATCH": {},
+}
+
+// QueryDLQMessages 按时间范围和 Key 浏览消费者组的死信消息
+func (s *MessageService) QueryDLQMessages(params model.DLQQueryParams) (*model.DLQQueryResult, error) {
+	group := strings.TrimSpace(params.Group)
+	if group == "" {
+		return nil, fmt.Errorf("查询死信消息失败: 消费者组名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	msgs, scanned, truncated, err := scanDLQMessages(client, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.DLQQueryResult{
+		Group:     group,
+		Topic:     dlqTopicPrefix + group,
+		Messages:  make([]*model.MessageItem, 0, len(msgs)),
+		Scanned:   scanned,
+		Truncated: truncated,
+	}
+	for _, msg := range msgs {
+		result.Messages = append(result.Messages, s.toMessageItem(msg))
+	}
+
+	return result, nil
+}
+
+// RedeliverDLQMessages 将死信消息重新投递到原始 Topic 或消费者组重试 Topic
+// 按 RatePerSecond 限速逐条发送；已成功重投过的消息会被记录，除非 Force 为 true，否则不会再次投递。
+func (s *MessageService) RedeliverDLQMessages(req model.DLQRedeliverRequest) (*model.DLQRedeliverResult, error) {
+	group := strings.TrimSpace(req.Group)
+	if group == "" {
+		return nil, fmt.Errorf("重投死信消息失败: 消费者组名称不能为空")
+	}
+	if req.Target != model.RedeliverToOrigin && req.Target != model.RedeliverToRetry {
+		return nil, fmt.Errorf("重投死信消息失败: 不支持的重投目标 %s", req.Target)
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+	producer, err := rocketmq.GetClientManager().GetDefaultProducer()
+	if err != nil {
+		return nil, fmt.Errorf("获取生产者失败: %w", err)
+	}
+
+	dlqTopic := dlqTopicPrefix + group
+	msgs := make([]*admin.MessageExt, 0)
+	if len(req.MessageIDs) > 0 {
+		for _, msgID := range req.MessageIDs {
+			msgID = strings.TrimSpace(msgID)
+			if msgID == "" {
+				continue
+			}
+
+			var msg *admin.MessageExt
+			err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+
+				found, callErr := retryClient.ViewMessage(ctx, dlqTopic, msgID)
+				if callErr != nil {
+					return callErr
+				}
+				msg = found
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("查询死信消息 %s 失败: %w", msgID, err)
+			}
+			msgs = append(msgs, msg)
+		}
+	} else {
+		filter := req.Filter
+		filter.Group = group
+		msgs, _, _, err = scanDLQMessages(client, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 只在读写记录文件时持锁，避免限速发送期间阻塞 GetDLQRedeliveryRecords
+	s.redeliveryMu.Lock()
+	records, err := s.loadRedeliveryRecordsLocked(group)
+	s.redeliveryMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	ratePerSecond := req.RatePerSecond
+	if ratePerSecond <= 0 {
+		ratePerSecond = defaultRedeliverRatePerSec
+	}
+	if ratePerSecond > maxRedeliverRatePerSec {
+		ratePerSecond = maxRedeliverRatePerSec
+	}
+	ticker := time.NewTicker(time.Second / time.Duration(ratePerSecond))
+	defer ticker.Stop()
+
+	result := &model.DLQRedeliverResult{
+		Group: group,
+		Items: make([]model.DLQRedeliverItem, 0, len(msgs)),
+	}
+
+	sent := 0
+	for _, msg := range msgs {
+		item := model.DLQRedeliverItem{
+			MessageID:   msg.MsgId,
+			OriginTopic: msg.Properties[propertyRetryTopic],
+		}
+		result.Total++
+
+		if record, delivered := records[msg.MsgId]; delivered && !req.Force {
+			item.Skipped = true
+			item.TargetTopic = record.TargetTopic
+			item.NewMessageID = record.NewMessageID
+			item.Error = fmt.Sprintf("已于 %s 重投", record.DeliveredAt)
+			result.Skipped++
+			result.Items = append(result.Items, item)
+			continue
+		}
+
+		if item.OriginTopic == "" {
+			item.Error = "消息缺少原始 Topic 属性(RETRY_TOPIC)"
+			result.Failed++
+			result.Items = append(result.Items, item)
+			continue
+		}
+
+		item.TargetTopic = item.OriginTopic
+		if req.Target == model.RedeliverToRetry {
+			item.TargetTopic = retryTopicPrefix + group
+		}
+
+		if sent > 0 {
+			<-ticker.C
+		}
+		sent++
+
+		sendResult, err := sendRedeliverMessage(producer, msg, item.TargetTopic, item.OriginTopic)
+		if err != nil {
+			item.Error = err.Error()
+			result.Failed++
+			result.Items = append(result.Items, item)
+			continue
+		}
+
+		item.Success = true
+		item.NewMessageID = sendResult.MsgID
+		result.Succeeded++
+		result.Items = append(result.Items, item)
+
+		// 每条成功后立即落盘，避免中途中断后重复投递
+		record := model.RedeliveryRecord{
+			MessageID:    msg.MsgId,
+			TargetTopic:  item.TargetTopic,
+			Target:       req.Target,
+			NewMessageID: sendResult.MsgID,
+			DeliveredAt:  formatNow(),
+		}
+		records[msg.MsgId] = record
+		if err := s.saveRedeliveryRecord(group, record); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// GetDLQRedeliveryRecords 获取消费者组的死信重投记录
+func (s *MessageService) GetDLQRedeliveryRecords(group string) ([]model.RedeliveryRecord, error) {
+	s.redeliveryMu.Lock()
+	defer s.redeliveryMu.Unlock()
+
+	records, err := s.loadRedeliveryRecordsLocked(strings.TrimSpace(group))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.RedeliveryRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+func (s *MessageService) redeliveryRecordPath(group string) string {
+	return filepath.Join(s.redeliveryDir, sanitizeFileName(group)+".json")
+}
+
+// saveRedeliveryRecord 追加一条重投记录并落盘
+// 写入前重新读取记录文件，合并同一消费者组其他重投任务期间写入的记录。
+func (s *MessageService) saveRedeliveryRecord(group string, record model.RedeliveryRecord) error {
+	s.redeliveryMu.Lock()
+	defer s.redeliveryMu.Unlock()
+
+	records, err := s.loadRedeliveryRecordsLocked(group)
+	if err != nil {
+		return err
+	}
+	records[record.MessageID] = record
+	if err := writeJSONFile(s.redeliveryRecordPath(group), records); err != nil {
+		return fmt.Errorf("保存重投记录失败: %w", err)
+	}
+	return nil
+}
+
+func (s *MessageService) loadRedeliveryRecordsLocked(group string) (map[string]model.RedeliveryRecord, error) {
+	records := make(map[string]model.RedeliveryRecord)
+	if _, err := readJSONFile(s.redeliveryRecordPath(group), &records); err != nil {
+		return nil, fmt.Errorf("读取重投记录失败: %w", err)
+	}
+	return records, nil
+}
+
+// scanDLQMessages 扫描死信 Topic 中符合条件的消息，返回消息、扫描数量以及是否被截断
+// 命中数达到 MaxResults 或扫描数达到 ScanLimit 时停止，避免 Key 命中很少时遍历整个死信 Topic。
+func scanDLQMessages(client *admin.Client, params model.DLQQueryParams) ([]*admin.MessageExt, int64, bool, error) {
+	maxResults := params.MaxResults
+	if maxResults <= 0 {
+		maxResults = defaultDLQMaxResults
+	}
+	if maxResults > maxDLQMaxResults {
+		maxResults = maxDLQMaxResults
+	}
+	scanLimit := params.ScanLimit
+	if scanLimit <= 0 {
+		scanLimit = defaultDLQScanLimit
+	}
+	if scanLimit > maxDLQScanLimit {
+		scanLimit = maxDLQScanLimit
+	}
+
+	opts := topicScanOptions{
+		topic:     dlqTopicPrefix + strings.TrimSpace(params.Group),
+		startTime: params.StartTime,
+		endTime:   params.EndTime,
+		key:       strings.TrimSpace(params.Key),
+		scanLimit: int64(scanLimit),
+	}
+
+	msgs := make([]*admin.MessageExt, 0)
+	truncated := false
+	scanned, err := scanTopicMessages(context.Background(), client, opts, func(msg *admin.MessageExt) bool {
+		if len(msgs) >= maxResults {
+			truncated = true
+			return false
+		}
+		msgs = append(msgs, msg)
+		return true
+	})
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	return msgs, scanned, truncated || scanned >= int64(scanLimit), nil
+}
+
+// messageMatchesKey 判断消息 Key、消息ID或原始消息ID是否匹配
+func messageMatchesKey(msg *admin.MessageExt, key string) bool {
+	if msg.MsgId == key {
+		return true
+	}
+	if msg.Properties == nil {
+		return false
+	}
+	if msg.Properties[propertyUniqKey] == key || msg.Properties[propertyOriginMessageID] == key {
+		return true
+	}
+	for _, msgKey := range strings.Fields(msg.Properties[propertyKeys]) {
+		if msgKey == key {
+			return true
+		}
+	}
+	return false
+}
+
+// sendRedeliverMessage 复制死信消息的消息体与用户属性并发送到目标 Topic
+// 投递到重试 Topic 时保留 RETRY_TOPIC，使消费者能还原原始 Topic。
+func sendRedeliverMessage(producer rmq.Producer, msg *admin.MessageExt, targetTopic string, originTopic string) (*primitive.SendResult, error) {
+	// 原消息体可能已压缩，发送前解压，由生产者按需重新压缩
+	body, _, _ := decompressBody(msg.Body, msg.SysFlag)
+	newMsg := primitive.NewMessage(targetTopic, body)
+	for key, value := range msg.Properties {
+		if _, dropped := redeliverDroppedProperties[key]; dropped || key == propertyRetryTopic {
+			continue
+		}
+		newMsg.WithProperty(key, value)
+	}
+	if targetTopic != originTopic {
+		newMsg.WithProperty(propertyRetryTopic, originTopic)
+	}
+	if msg.Properties[propertyOriginMessageID] == "" {
+		newMsg.WithProperty(propertyOriginMessageID, msg.MsgId)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	sendResult, err := producer.SendSync(ctx, newMsg)
+	if err != nil {
+		return nil, err
+	}
+	if sendResult.Status != primitive.SendOK {
+		return sendResult, fmt.Errorf("发送状态异常: %v", sendResult.Status)
+	}
+	return sendResult, nil
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+)
+
+const messageExportDirName = "exports"
+
+// exportCSVHeader CSV 导出列，回放时按列名解析
+var exportCSVHeader = []string{
+	"topic", "messageId", "tags", "keys", "queueId", "queueOffset", "storeHost", "bornHost",
+	"storeTimestamp", "retryTimes", "bodyEncoding", "compression", "compressed", "body", "properties",
+}
+
+// ExportMessages 将查询或浏览结果导出为 JSONL 或 CSV 文件
+// 导出完整的属性与消息体，二进制消息体以 base64 表示，导出文件可用于 StartReplay 回放。
+func (s *MessageService) ExportMessages(req model.MessageExportRequest) (*model.MessageExportResult, error) {
+	format := req.Format
+	if format == "" {
+		format = model.ExportJSONL
+	}
+	if format != model.ExportJSONL && format != model.ExportCSV {
+		return nil, fmt.Errorf("导出消息失败: 不支持的导出格式 %s", format)
+	}
+
+	items, topic, truncated, err := s.collectExportMessages(req)
+	if err != nil {
+		return nil, fmt.Errorf("导出消息失败: %w", err)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("导出消息失败: 没有可导出的消息")
+	}
+
+	filePath := strings.TrimSpace(req.FilePath)
+	if filePath == "" {
+		fileName := fmt.Sprintf("%s-%s.%s", sanitizeFileName(topic), time.Now().Format("20060102150405"), format)
+		filePath = filepath.Join(resolveAppDataDir(messageExportDirName), fileName)
+	}
+
+	records := make([]model.ExportedMessage, 0, len(items))
+	result := &model.MessageExportResult{FilePath: filePath, Format: format, Truncated: truncated}
+	for _, item := range items {
+		record := toExportedMessage(item)
+		if record.BodyEncoding == model.BodyEncodingBase64 {
+			result.BinaryCount++
+		}
+		if record.Compressed {
+			result.CompressedCount++
+		}
+		records = append(records, record)
+	}
+
+	if err := writeExportFile(filePath, format, records); err != nil {
+		return nil, fmt.Errorf("导出消息失败: %w", err)
+	}
+
+	result.Count = len(records)
+	return result, nil
+}
+
+// collectExportMessages 按导出来源获取消息，返回消息、用于生成文件名的 Topic 以及结果是否因数量上限被截断
+func (s *MessageService) collectExportMessages(req model.MessageExportRequest) ([]*model.MessageItem, string, bool, error) {
+	sources := 0
+	if req.TimeQuery != nil {
+		sources++
+	}
+	if req.Browse != nil {
+		sources++
+	}
+	if len(req.Messages) > 0 {
+		sources++
+	}
+	if sources != 1 {
+		return nil, "", false, fmt.Errorf("时间查询、队列浏览、消息列表必须且只能指定一个")
+	}
+
+	switch {
+	case req.TimeQuery != nil:
+		params := *req.TimeQuery
+		params.PageNum = 1
+		params.PageSize = maxTimeQueryMaxResults
+		page, err := s.QueryMessagesByTime(params)
+		if err != nil {
+			return nil, "", false, err
+		}
+		return page.Messages, page.Topic, page.Truncated, nil
+	case req.Browse != nil:
+		browse, err := s.BrowseQueueMessages(*req.Browse)
+		if err != nil {
+			return nil, "", false, err
+		}
+		return browse.Messages, browse.Topic, false, nil
+	default:
+		items := make([]*model.MessageItem, 0, len(req.Messages))
+		for _, item := range req.Messages {
+			if item != nil {
+				items = append(items, item)
+			}
+		}
+		topic := "messages"
+		if len(items) > 0 && items[0].Topic != "" {
+			topic = items[0].Topic
+		}
+		return items, topic, false, nil
+	}
+}
+
+// toExportedMessage 将消息转换为导出记录，编码缺省时视为文本
+// 未能解压的消息体按原始压缩数据导出并标记 Compressed，回放时拒绝发送。
+func toExportedMessage(item *model.MessageItem) model.ExportedMessage {
+	encoding := item.BodyEncoding
+	if encoding == "" {
+		encoding = model.BodyEncodingText
+	}
+	return model.ExportedMessage{
+		Topic:          item.Topic,
+		MessageID:      item.MessageID,
+		Tags:           item.Tags,
+		Keys:           item.Keys,
+		QueueID:        item.QueueID,
+		QueueOffset:    item.QueueOffset,
+		StoreHost:      item.StoreHost,
+		BornHost:       item.BornHost,
+		StoreTimestamp: item.StoreTimestamp,
+		RetryTimes:     item.RetryTimes,
+		BodyEncoding:   encoding,
+		Compression:    item.Compression,
+		Compressed:     item.Compressed,
+		Body:           item.Body,
+		Properties:     item.Properties,
+	}
+}
+
+// writeExportFile 写入导出文件，先写临时文件再重命名，避免留下半个文件
+func writeExportFile(path string, format model.ExportFormat, records []model.ExportedMessage) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tempFilePath := path + ".tmp"
+	file, err := os.OpenFile(tempFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	if format == model.ExportCSV {
+		err = writeExportCSV(writer, records)
+	} else {
+		err = writeExportJSONL(writer, records)
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempFilePath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tempFilePath)
+		return err
+	}
+	return nil
+}
+
+func writeExportJSONL(w io.Writer, records []model.ExportedMessage) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeExportCSV(w io.Writer, records []model.ExportedMessage) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportCSVHeader); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		properties, err := json.Marshal(record.Properties)
+		if err != nil {
+			return err
+		}
+		row := []string{
+			record.Topic,
+			record.MessageID,
+			record.Tags,
+			record.Keys,
+			strconv.Itoa(record.QueueID),
+			strconv.FormatInt(record.QueueOffset, 10),
+			record.StoreHost,
+			record.BornHost,
+			strconv.FormatInt(record.StoreTimestamp, 10),
+			strconv.Itoa(record.RetryTimes),
+			string(record.BodyEncoding),
+			record.Compression,
+			strconv.FormatBool(record.Compressed),
+			record.Body,
+			string(properties),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// readExportFile 读取导出文件，扩展名为 .csv 时按 CSV 解析，其余按 JSONL 解析
+func readExportFile(path string) ([]model.ExportedMessage, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return readExportCSV(file)
+	}
+	return readExportJSONL(file)
+}
+
+func readExportJSONL(r io.Reader) ([]model.ExportedMessage, error) {
+	scanner := bufio.NewScanner(r)
+	// 单条消息体最大 4MB，base64 后约 5.4MB，预留属性空间
+	scanner.Buffer(make([]byte, 64*1024), 8*1024*1024)
+
+	records := make([]model.ExportedMessage, 0)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var record model.ExportedMessage
+		if err := json.Unmarshal([]byte(text), &record); err != nil {
+			return nil, fmt.Errorf("第 %d 行解析失败: %w", line, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func readExportCSV(r io.Reader) ([]model.ExportedMessage, error) {
+	reader := csv.NewReader(bufio.NewReader(r))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("读取表头失败: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for index, name := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = index
+	}
+	if _, ok := columns["body"]; !ok {
+		return nil, fmt.Errorf("缺少 body 列")
+	}
+
+	records := make([]model.ExportedMessage, 0)
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("第 %d 行解析失败: %w", line, err)
+		}
+
+		field := func(name string) string {
+			if index, ok := columns[name]; ok && index < len(row) {
+				return row[index]
+			}
+			return ""
+		}
+
+		record := model.ExportedMessage{
+			Topic:          field("topic"),
+			MessageID:      field("messageId"),
+			Tags:           field("tags"),
+			Keys:           field("keys"),
+			QueueID:        parseIntSafe(field("queueId")),
+			QueueOffset:    parseInt64Safe(field("queueOffset")),
+			StoreHost:      field("storeHost"),
+			BornHost:       field("bornHost"),
+			StoreTimestamp: parseInt64Safe(field("storeTimestamp")),
+			RetryTimes:     parseIntSafe(field("retryTimes")),
+			BodyEncoding:   model.BodyEncoding(field("bodyEncoding")),
+			Compression:    field("compression"),
+			Body:           field("body"),
+		}
+		if compressed := strings.TrimSpace(field("compressed")); compressed != "" {
+			if record.Compressed, err = strconv.ParseBool(compressed); err != nil {
+				return nil, fmt.Errorf("第 %d 行 compressed 列解析失败: %w", line, err)
+			}
+		}
+		if properties := strings.TrimSpace(field("properties")); properties != "" && properties != "null" {
+			if err := json.Unmarshal([]byte(properties), &record.Properties); err != nil {
+				return nil, fmt.Errorf("第 %d 行属性解析失败: %w", line, err)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
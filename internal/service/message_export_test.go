@@ -0,0 +1,132 @@
+package service
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"rocket-leaf/internal/model"
+)
+
+func TestExportFileRoundTrip(t *testing.T) {
+	records := []model.ExportedMessage{
+		{
+			Topic:          "orders",
+			MessageID:      "7F00000100002A9F0000000000000001",
+			Tags:           "TagA",
+			Keys:           "k1 k2",
+			QueueID:        3,
+			QueueOffset:    42,
+			StoreHost:      "10.0.0.1:10911",
+			BornHost:       "10.0.0.2:50000",
+			StoreTimestamp: 1700000000000,
+			RetryTimes:     1,
+			BodyEncoding:   model.BodyEncodingText,
+			Body:           "含逗号, \"引号\"\n与换行的 <文本>",
+			Properties:     map[string]string{"region": "hz", "note": "a,b\"c"},
+		},
+		{
+			Topic:        "orders",
+			MessageID:    "7F00000100002A9F0000000000000002",
+			BodyEncoding: model.BodyEncodingBase64,
+			Body:         "AAH/",
+		},
+		{
+			Topic:        "orders",
+			MessageID:    "7F00000100002A9F0000000000000003",
+			BodyEncoding: model.BodyEncodingBase64,
+			Compression:  "zstd",
+			Compressed:   true,
+			Body:         "KLUv/Q==",
+		},
+	}
+
+	for _, format := range []model.ExportFormat{model.ExportJSONL, model.ExportCSV} {
+		t.Run(string(format), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "messages."+string(format))
+			if err := writeExportFile(path, format, records); err != nil {
+				t.Fatalf("写入导出文件失败: %v", err)
+			}
+			if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+				t.Errorf("不应残留临时文件")
+			}
+
+			got, err := readExportFile(path)
+			if err != nil {
+				t.Fatalf("读取导出文件失败: %v", err)
+			}
+			if !reflect.DeepEqual(got, records) {
+				t.Errorf("读回的记录 = %+v\n期望 %+v", got, records)
+			}
+
+			msg, err := buildReplayMessage(got[1], "replay-topic", model.MessageReplayRequest{})
+			if err != nil {
+				t.Fatalf("构造回放消息失败: %v", err)
+			}
+			if !bytes.Equal(msg.Body, []byte{0x00, 0x01, 0xFF}) {
+				t.Errorf("回放消息体 = %v, 期望 base64 解码后的原始字节", msg.Body)
+			}
+			if _, err := buildReplayMessage(got[2], "replay-topic", model.MessageReplayRequest{}); err == nil {
+				t.Errorf("仍为压缩数据的消息体应拒绝回放")
+			}
+		})
+	}
+}
+
+func TestReadExportCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []model.ExportedMessage
+		wantErr string
+	}{
+		{
+			name:    "带 BOM 且列顺序不同",
+			content: "\ufeffbody,topic,properties\nhello,orders,\"{\"\"a\"\":\"\"1\"\"}\"\n",
+			want:    []model.ExportedMessage{{Topic: "orders", Body: "hello", Properties: map[string]string{"a": "1"}}},
+		},
+		{
+			name:    "缺少的列为空值",
+			content: "topic,body\norders,hello\n",
+			want:    []model.ExportedMessage{{Topic: "orders", Body: "hello"}},
+		},
+		{name: "缺少 body 列", content: "topic\norders\n", wantErr: "缺少 body 列"},
+		{name: "属性不是 JSON", content: "body,properties\nhello,oops\n", wantErr: "第 2 行属性解析失败"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readExportCSV(strings.NewReader(tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("错误 = %v, 期望包含 %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("解析失败: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("解析结果 = %+v\n期望 %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadExportJSONL(t *testing.T) {
+	got, err := readExportJSONL(strings.NewReader("{\"topic\":\"orders\",\"body\":\"a\"}\n\n  \n{\"topic\":\"orders\",\"body\":\"b\"}\n"))
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+	want := []model.ExportedMessage{{Topic: "orders", Body: "a"}, {Topic: "orders", Body: "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("解析结果 = %+v\n期望 %+v", got, want)
+	}
+
+	if _, err := readExportJSONL(strings.NewReader("{\"topic\":\"orders\"}\n{broken\n")); err == nil || !strings.Contains(err.Error(), "第 2 行解析失败") {
+		t.Errorf("错误 = %v, 期望指出第 2 行", err)
+	}
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const (
+	defaultFilterMaxResults = 100
+	maxFilterMaxResults     = 1000
+	defaultFilterScanLimit  = 10000
+	maxFilterScanLimit      = 100000
+)
+
+// messageFilter 订阅过滤条件
+type messageFilter struct {
+	tags    map[string]struct{} // Tag 表达式解析出的标签，nil 表示订阅全部
+	tagList []string
+	sql     sqlExpr
+}
+
+// newMessageFilter 解析订阅表达式，返回过滤条件与 SQL92 引用的属性
+func newMessageFilter(expressionType model.FilterExpressionType, expression string) (*messageFilter, []string, error) {
+	switch expressionType {
+	case model.FilterTag, "":
+		filter := &messageFilter{tagList: parseTagExpression(expression)}
+		if filter.tagList != nil {
+			filter.tags = make(map[string]struct{}, len(filter.tagList))
+			for _, tag := range filter.tagList {
+				filter.tags[tag] = struct{}{}
+			}
+		}
+		return filter, nil, nil
+	case model.FilterSQL92:
+		expr, properties, err := parseSQLFilter(expression)
+		if err != nil {
+			return nil, nil, fmt.Errorf("SQL92 表达式解析失败: %w", err)
+		}
+		return &messageFilter{sql: expr}, properties, nil
+	default:
+		return nil, nil, fmt.Errorf("不支持的表达式类型: %s", expressionType)
+	}
+}
+
+// match 按 RocketMQ 订阅语义判断消息是否会投递，未命中时返回原因
+func (f *messageFilter) match(msg *admin.MessageExt) (sqlTruth, string) {
+	if f.sql != nil {
+		ctx := newSQLEvalContext(msg.Properties)
+		result := f.sql.eval(ctx)
+		if result == sqlTrue {
+			return result, ""
+		}
+		return result, ctx.reason(result)
+	}
+
+	if f.tags == nil {
+		return sqlTrue, ""
+	}
+	tag := msg.Properties[propertyTags]
+	if tag == "" {
+		return sqlFalse, "消息没有标签，只有订阅 * 时才会投递"
+	}
+	if _, ok := f.tags[tag]; !ok {
+		return sqlFalse, fmt.Sprintf("标签 %s 不在订阅标签 [%s] 中", tag, strings.Join(f.tagList, " || "))
+	}
+	return sqlTrue, ""
+}
+
+// FilterMessages 按 Tag 或 SQL92 订阅表达式预览消息过滤结果
+// 在时间范围或单个队列的位点区间内扫描消息，按 Broker 的过滤语义求值，返回命中与未命中的消息及原因、统计。
+func (s *MessageService) FilterMessages(req model.MessageFilterRequest) (*model.MessageFilterResult, error) {
+	topic := strings.TrimSpace(req.Topic)
+	if topic == "" {
+		return nil, fmt.Errorf("过滤消息失败: Topic 不能为空")
+	}
+
+	expressionType := req.ExpressionType
+	if expressionType == "" {
+		expressionType = model.FilterTag
+	}
+	filter, properties, err := newMessageFilter(expressionType, req.Expression)
+	if err != nil {
+		return nil, fmt.Errorf("过滤消息失败: %w", err)
+	}
+
+	maxResults := req.MaxResults
+	if maxResults <= 0 {
+		maxResults = defaultFilterMaxResults
+	}
+	if maxResults > maxFilterMaxResults {
+		maxResults = maxFilterMaxResults
+	}
+	scanLimit := req.ScanLimit
+	if scanLimit <= 0 {
+		scanLimit = defaultFilterScanLimit
+	}
+	if scanLimit > maxFilterScanLimit {
+		scanLimit = maxFilterScanLimit
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	result := &model.MessageFilterResult{
+		Topic:          topic,
+		ExpressionType: expressionType,
+		Expression:     strings.TrimSpace(req.Expression),
+		Tags:           filter.tagList,
+		Properties:     properties,
+		Matches:        make([]model.FilteredMessage, 0),
+		Mismatches:     make([]model.FilteredMessage, 0),
+	}
+
+	tagStats := make(map[string]*model.FilterTagStat)
+	propertyStats := make(map[string]*model.FilterPropertyStat, len(properties))
+	for _, property := range properties {
+		propertyStats[property] = &model.FilterPropertyStat{Property: property}
+	}
+
+	visit := func(msg *admin.MessageExt) bool {
+		if result.Scanned >= scanLimit {
+			result.Truncated = true
+			return false
+		}
+		result.Scanned++
+
+		truth, reason := filter.match(msg)
+		matched := truth == sqlTrue
+
+		tag := msg.Properties[propertyTags]
+		stat, ok := tagStats[tag]
+		if !ok {
+			stat = &model.FilterTagStat{Tag: tag}
+			tagStats[tag] = stat
+		}
+		stat.Total++
+		for property, propertyStat := range propertyStats {
+			if _, exists := msg.Properties[property]; exists {
+				propertyStat.Present++
+			} else {
+				propertyStat.Missing++
+			}
+		}
+
+		item := model.FilteredMessage{Matched: matched, Result: truth.String(), Reason: reason}
+		if matched {
+			stat.Matched++
+			result.Matched++
+			if len(result.Matches) < maxResults {
+				item.Message = s.toMessageItem(msg)
+				result.Matches = append(result.Matches, item)
+			}
+			return true
+		}
+
+		result.Unmatched++
+		if truth == sqlUnknown {
+			result.Unknown++
+		}
+		if len(result.Mismatches) < maxResults {
+			item.Message = s.toMessageItem(msg)
+			result.Mismatches = append(result.Mismatches, item)
+		}
+		return true
+	}
+
+	if brokerName := strings.TrimSpace(req.BrokerName); brokerName != "" {
+		err = scanQueueOffsetRange(client, topic, brokerName, req.QueueID, req.StartOffset, req.EndOffset, visit)
+	} else {
+		opts := topicScanOptions{
+			topic:     topic,
+			startTime: req.StartTime,
+			endTime:   req.EndTime,
+		}
+		_, err = scanTopicMessages(context.Background(), client, opts, visit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("过滤消息失败: %w", err)
+	}
+
+	if result.Scanned > 0 {
+		result.MatchRate = float64(result.Matched) * 100 / float64(result.Scanned)
+	}
+
+	result.TagStats = make([]model.FilterTagStat, 0, len(tagStats))
+	for _, stat := range tagStats {
+		result.TagStats = append(result.TagStats, *stat)
+	}
+	sort.Slice(result.TagStats, func(i, j int) bool {
+		if result.TagStats[i].Total != result.TagStats[j].Total {
+			return result.TagStats[i].Total > result.TagStats[j].Total
+		}
+		return result.TagStats[i].Tag < result.TagStats[j].Tag
+	})
+
+	result.PropertyStats = make([]model.FilterPropertyStat, 0, len(properties))
+	for _, property := range properties {
+		result.PropertyStats = append(result.PropertyStats, *propertyStats[property])
+	}
+
+	return result, nil
+}
+
+// scanQueueOffsetRange 扫描单个队列位点区间 [start, end) 内的消息，超出队列范围的部分自动收窄
+func scanQueueOffsetRange(client *admin.Client, topic string, brokerName string, queueID int, start int64, end int64, visit func(*admin.MessageExt) bool) error {
+	queue, err := findTopicQueue(client, topic, brokerName, queueID)
+	if err != nil {
+		return err
+	}
+
+	minOffset, maxOffset, err := fetchQueueOffsetRange(client, queue)
+	if err != nil {
+		return fmt.Errorf("获取队列位点失败: %w", err)
+	}
+	if start < minOffset {
+		start = minOffset
+	}
+	if end <= 0 || end > maxOffset {
+		end = maxOffset
+	}
+
+	_, err = scanQueueRange(context.Background(), client, queue, start, end, visit)
+	return err
+}
@@ -0,0 +1,696 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SQL92 过滤表达式求值，语义与 RocketMQ Broker 的属性过滤保持一致：
+// 属性值均为字符串，数值比较时按数字解析；引用不存在的属性时比较结果为 UNKNOWN，
+// UNKNOWN 参与 AND/OR/NOT 时按 SQL 三值逻辑计算，只有最终结果为 TRUE 的消息才会投递。
+
+// sqlTruth SQL 三值逻辑结果
+type sqlTruth int
+
+const (
+	sqlFalse sqlTruth = iota
+	sqlTrue
+	sqlUnknown
+)
+
+func (t sqlTruth) String() string {
+	switch t {
+	case sqlTrue:
+		return "TRUE"
+	case sqlFalse:
+		return "FALSE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func sqlNot(t sqlTruth) sqlTruth {
+	switch t {
+	case sqlTrue:
+		return sqlFalse
+	case sqlFalse:
+		return sqlTrue
+	default:
+		return sqlUnknown
+	}
+}
+
+// sqlEvalContext 单条消息的求值上下文，记录导致 UNKNOWN/FALSE 的原因
+type sqlEvalContext struct {
+	properties map[string]string
+	missing    map[string]struct{} // 被引用但不存在的属性
+	notNumeric map[string]struct{} // 数值比较时无法解析为数字的属性
+}
+
+func newSQLEvalContext(properties map[string]string) *sqlEvalContext {
+	return &sqlEvalContext{
+		properties: properties,
+		missing:    make(map[string]struct{}),
+		notNumeric: make(map[string]struct{}),
+	}
+}
+
+// reason 汇总不匹配原因
+func (c *sqlEvalContext) reason(result sqlTruth) string {
+	parts := make([]string, 0, 3)
+	if len(c.missing) > 0 {
+		parts = append(parts, fmt.Sprintf("属性 %s 不存在", strings.Join(sortedKeys(c.missing), ", ")))
+	}
+	if len(c.notNumeric) > 0 {
+		parts = append(parts, fmt.Sprintf("属性 %s 不是数字", strings.Join(sortedKeys(c.notNumeric), ", ")))
+	}
+	parts = append(parts, "表达式结果为 "+result.String())
+	return strings.Join(parts, "，")
+}
+
+// sqlExpr 布尔表达式节点
+type sqlExpr interface {
+	eval(ctx *sqlEvalContext) sqlTruth
+}
+
+// sqlValueKind 操作数类型
+type sqlValueKind int
+
+const (
+	sqlValueString sqlValueKind = iota
+	sqlValueNumber
+	sqlValueBool
+)
+
+// sqlOperand 比较操作数：属性或常量
+type sqlOperand struct {
+	property string // 属性名，为空时表示常量
+	kind     sqlValueKind
+	text     string
+	number   float64
+}
+
+// resolve 获取操作数的字符串值，属性不存在时返回 false
+func (o sqlOperand) resolve(ctx *sqlEvalContext) (string, bool) {
+	if o.property == "" {
+		return o.text, true
+	}
+	value, ok := ctx.properties[o.property]
+	if !ok {
+		ctx.missing[o.property] = struct{}{}
+	}
+	return value, ok
+}
+
+// resolveNumber 获取操作数的数值
+func (o sqlOperand) resolveNumber(ctx *sqlEvalContext) (float64, sqlTruth) {
+	if o.property == "" {
+		if o.kind != sqlValueNumber {
+			return 0, sqlFalse
+		}
+		return o.number, sqlTrue
+	}
+	value, ok := o.resolve(ctx)
+	if !ok {
+		return 0, sqlUnknown
+	}
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		ctx.notNumeric[o.property] = struct{}{}
+		return 0, sqlFalse
+	}
+	return number, sqlTrue
+}
+
+type sqlLiteralExpr struct{ value bool }
+
+func (e sqlLiteralExpr) eval(*sqlEvalContext) sqlTruth {
+	if e.value {
+		return sqlTrue
+	}
+	return sqlFalse
+}
+
+type sqlAndExpr struct{ left, right sqlExpr }
+
+func (e sqlAndExpr) eval(ctx *sqlEvalContext) sqlTruth {
+	left := e.left.eval(ctx)
+	if left == sqlFalse {
+		return sqlFalse
+	}
+	right := e.right.eval(ctx)
+	if right == sqlFalse {
+		return sqlFalse
+	}
+	if left == sqlUnknown || right == sqlUnknown {
+		return sqlUnknown
+	}
+	return sqlTrue
+}
+
+type sqlOrExpr struct{ left, right sqlExpr }
+
+func (e sqlOrExpr) eval(ctx *sqlEvalContext) sqlTruth {
+	left := e.left.eval(ctx)
+	if left == sqlTrue {
+		return sqlTrue
+	}
+	right := e.right.eval(ctx)
+	if right == sqlTrue {
+		return sqlTrue
+	}
+	if left == sqlUnknown || right == sqlUnknown {
+		return sqlUnknown
+	}
+	return sqlFalse
+}
+
+type sqlNotExpr struct{ inner sqlExpr }
+
+func (e sqlNotExpr) eval(ctx *sqlEvalContext) sqlTruth {
+	return sqlNot(e.inner.eval(ctx))
+}
+
+// sqlCompareExpr 比较表达式：=、<>、>、>=、<、<=
+type sqlCompareExpr struct {
+	op          string
+	left, right sqlOperand
+}
+
+func (e sqlCompareExpr) eval(ctx *sqlEvalContext) sqlTruth {
+	numeric := e.left.kind == sqlValueNumber || e.right.kind == sqlValueNumber || (e.op != "=" && e.op != "<>")
+	if e.left.kind == sqlValueBool || e.right.kind == sqlValueBool {
+		return e.evalBool(ctx)
+	}
+	if !numeric {
+		left, leftOK := e.left.resolve(ctx)
+		right, rightOK := e.right.resolve(ctx)
+		if !leftOK || !rightOK {
+			return sqlUnknown
+		}
+		equal := left == right
+		if e.op == "<>" {
+			equal = !equal
+		}
+		return truthOf(equal)
+	}
+
+	left, leftTruth := e.left.resolveNumber(ctx)
+	right, rightTruth := e.right.resolveNumber(ctx)
+	if leftTruth == sqlUnknown || rightTruth == sqlUnknown {
+		return sqlUnknown
+	}
+	if leftTruth == sqlFalse || rightTruth == sqlFalse {
+		return sqlFalse
+	}
+
+	switch e.op {
+	case "=":
+		return truthOf(left == right)
+	case "<>":
+		return truthOf(left != right)
+	case ">":
+		return truthOf(left > right)
+	case ">=":
+		return truthOf(left >= right)
+	case "<":
+		return truthOf(left < right)
+	default:
+		return truthOf(left <= right)
+	}
+}
+
+func (e sqlCompareExpr) evalBool(ctx *sqlEvalContext) sqlTruth {
+	left, leftOK := e.left.resolve(ctx)
+	right, rightOK := e.right.resolve(ctx)
+	if !leftOK || !rightOK {
+		return sqlUnknown
+	}
+	equal := strings.EqualFold(left, right)
+	if e.op == "<>" {
+		equal = !equal
+	}
+	return truthOf(equal)
+}
+
+// sqlBetweenExpr [NOT] BETWEEN low AND high
+type sqlBetweenExpr struct {
+	operand   sqlOperand
+	low, high float64
+	negate    bool
+}
+
+func (e sqlBetweenExpr) eval(ctx *sqlEvalContext) sqlTruth {
+	value, truth := e.operand.resolveNumber(ctx)
+	if truth != sqlTrue {
+		return truth
+	}
+	result := truthOf(value >= e.low && value <= e.high)
+	if e.negate {
+		return sqlNot(result)
+	}
+	return result
+}
+
+// sqlInExpr [NOT] IN ('a', 'b')
+type sqlInExpr struct {
+	operand sqlOperand
+	values  map[string]struct{}
+	negate  bool
+}
+
+func (e sqlInExpr) eval(ctx *sqlEvalContext) sqlTruth {
+	value, ok := e.operand.resolve(ctx)
+	if !ok {
+		return sqlUnknown
+	}
+	_, found := e.values[value]
+	return truthOf(found != e.negate)
+}
+
+// sqlNullExpr IS [NOT] NULL
+type sqlNullExpr struct {
+	property string
+	negate   bool
+}
+
+func (e sqlNullExpr) eval(ctx *sqlEvalContext) sqlTruth {
+	_, exists := ctx.properties[e.property]
+	return truthOf(exists == e.negate)
+}
+
+// sqlStringMatchExpr [NOT] CONTAINS / STARTSWITH / ENDSWITH
+type sqlStringMatchExpr struct {
+	op      string
+	operand sqlOperand
+	pattern string
+	negate  bool
+}
+
+func (e sqlStringMatchExpr) eval(ctx *sqlEvalContext) sqlTruth {
+	value, ok := e.operand.resolve(ctx)
+	if !ok {
+		return sqlUnknown
+	}
+
+	var matched bool
+	switch e.op {
+	case "CONTAINS":
+		matched = strings.Contains(value, e.pattern)
+	case "STARTSWITH":
+		matched = strings.HasPrefix(value, e.pattern)
+	default:
+		matched = strings.HasSuffix(value, e.pattern)
+	}
+	return truthOf(matched != e.negate)
+}
+
+func truthOf(value bool) sqlTruth {
+	if value {
+		return sqlTrue
+	}
+	return sqlFalse
+}
+
+// sqlToken 词法单元
+type sqlToken struct {
+	kind  string // ident、string、number、op、keyword、eof
+	text  string
+	value string
+	pos   int
+}
+
+var sqlKeywords = map[string]struct{}{
+	"AND": {}, "OR": {}, "NOT": {}, "BETWEEN": {}, "IN": {}, "IS": {}, "NULL": {},
+	"TRUE": {}, "FALSE": {}, "CONTAINS": {}, "STARTSWITH": {}, "ENDSWITH": {},
+}
+
+// tokenizeSQL 将表达式切分为词法单元
+func tokenizeSQL(expression string) ([]sqlToken, error) {
+	tokens := make([]sqlToken, 0)
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'':
+			var sb strings.Builder
+			start := i
+			i++
+			closed := false
+			for i < len(runes) {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						sb.WriteRune('\'')
+						i += 2
+						continue
+					}
+					i++
+					closed = true
+					break
+				}
+				sb.WriteRune(runes[i])
+				i++
+			}
+			if !closed {
+				return nil, fmt.Errorf("位置 %d 的字符串缺少结束引号", start+1)
+			}
+			tokens = append(tokens, sqlToken{kind: "string", text: string(runes[start:i]), value: sb.String(), pos: start})
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1]) && expectsOperand(tokens)) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' || runes[i] == 'E' ||
+				((runes[i] == '+' || runes[i] == '-') && (runes[i-1] == 'e' || runes[i-1] == 'E'))) {
+				i++
+			}
+			// 兼容 Java 长整型后缀，如 10L
+			text := string(runes[start:i])
+			if i < len(runes) && (runes[i] == 'L' || runes[i] == 'l') {
+				i++
+			}
+			tokens = append(tokens, sqlToken{kind: "number", text: text, value: text, pos: start})
+		case unicode.IsLetter(r) || r == '_' || r == '$':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '$' || runes[i] == '.') {
+				i++
+			}
+			text := string(runes[start:i])
+			upper := strings.ToUpper(text)
+			if _, ok := sqlKeywords[upper]; ok {
+				tokens = append(tokens, sqlToken{kind: "keyword", text: text, value: upper, pos: start})
+			} else {
+				tokens = append(tokens, sqlToken{kind: "ident", text: text, value: text, pos: start})
+			}
+		default:
+			start := i
+			op := string(r)
+			if i+1 < len(runes) {
+				switch two := string(runes[i : i+2]); two {
+				case "<>", ">=", "<=", "!=":
+					op = two
+				}
+			}
+			i += len([]rune(op))
+			if op == "!=" {
+				op = "<>"
+			}
+			switch op {
+			case "=", "<>", ">", ">=", "<", "<=", "(", ")", ",":
+			default:
+				return nil, fmt.Errorf("位置 %d 存在无法识别的字符 %q", start+1, r)
+			}
+			tokens = append(tokens, sqlToken{kind: "op", text: op, value: op, pos: start})
+		}
+	}
+	tokens = append(tokens, sqlToken{kind: "eof", pos: len(runes)})
+	return tokens, nil
+}
+
+// expectsOperand 判断下一个词法单元是否应为操作数（用于区分负号与减号）
+func expectsOperand(tokens []sqlToken) bool {
+	if len(tokens) == 0 {
+		return true
+	}
+	last := tokens[len(tokens)-1]
+	return last.kind == "op" && last.value != ")" || last.kind == "keyword"
+}
+
+// sqlParser 递归下降解析器
+type sqlParser struct {
+	tokens []sqlToken
+	pos    int
+}
+
+// parseSQLFilter 解析 SQL92 过滤表达式
+func parseSQLFilter(expression string) (sqlExpr, []string, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil, fmt.Errorf("SQL92 表达式不能为空")
+	}
+	tokens, err := tokenizeSQL(expression)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parser := &sqlParser{tokens: tokens}
+	expr, err := parser.parseOr()
+	if err != nil {
+		return nil, nil, err
+	}
+	if token := parser.peek(); token.kind != "eof" {
+		return nil, nil, fmt.Errorf("位置 %d 存在多余的内容 %q", token.pos+1, token.text)
+	}
+
+	properties := make(map[string]struct{})
+	for _, token := range tokens {
+		if token.kind == "ident" {
+			properties[token.value] = struct{}{}
+		}
+	}
+	return expr, sortedKeys(properties), nil
+}
+
+func (p *sqlParser) peek() sqlToken {
+	return p.tokens[p.pos]
+}
+
+func (p *sqlParser) next() sqlToken {
+	token := p.tokens[p.pos]
+	if token.kind != "eof" {
+		p.pos++
+	}
+	return token
+}
+
+func (p *sqlParser) acceptKeyword(keyword string) bool {
+	if token := p.peek(); token.kind == "keyword" && token.value == keyword {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) acceptOp(op string) bool {
+	if token := p.peek(); token.kind == "op" && token.value == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) errorf(format string, args ...interface{}) error {
+	token := p.peek()
+	if token.kind == "eof" {
+		return fmt.Errorf("表达式不完整: "+format, args...)
+	}
+	return fmt.Errorf("位置 %d（%q）: "+format, append([]interface{}{token.pos + 1, token.text}, args...)...)
+}
+
+func (p *sqlParser) parseOr() (sqlExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = sqlOrExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *sqlParser) parseAnd() (sqlExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = sqlAndExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *sqlParser) parseNot() (sqlExpr, error) {
+	if p.acceptKeyword("NOT") {
+		inner, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return sqlNotExpr{inner: inner}, nil
+	}
+	return p.parsePredicate()
+}
+
+func (p *sqlParser) parsePredicate() (sqlExpr, error) {
+	if p.acceptOp("(") {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptOp(")") {
+			return nil, p.errorf("缺少右括号")
+		}
+		return expr, nil
+	}
+
+	token := p.peek()
+	if token.kind == "keyword" && (token.value == "TRUE" || token.value == "FALSE") {
+		// 单独的布尔常量，后面跟比较运算符时按操作数处理
+		if next := p.tokens[p.pos+1]; next.kind != "op" || next.value == ")" || next.value == "," {
+			p.pos++
+			return sqlLiteralExpr{value: token.value == "TRUE"}, nil
+		}
+	}
+
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.acceptKeyword("IS") {
+		if left.property == "" {
+			return nil, p.errorf("IS NULL 只能用于属性")
+		}
+		negate := p.acceptKeyword("NOT")
+		if !p.acceptKeyword("NULL") {
+			return nil, p.errorf("IS 后应为 NULL")
+		}
+		return sqlNullExpr{property: left.property, negate: negate}, nil
+	}
+
+	negate := p.acceptKeyword("NOT")
+	switch {
+	case p.acceptKeyword("BETWEEN"):
+		low, err := p.parseNumber()
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptKeyword("AND") {
+			return nil, p.errorf("BETWEEN 缺少 AND")
+		}
+		high, err := p.parseNumber()
+		if err != nil {
+			return nil, err
+		}
+		return sqlBetweenExpr{operand: left, low: low, high: high, negate: negate}, nil
+	case p.acceptKeyword("IN"):
+		if !p.acceptOp("(") {
+			return nil, p.errorf("IN 后应为左括号")
+		}
+		values := make(map[string]struct{})
+		for {
+			token := p.next()
+			if token.kind != "string" {
+				return nil, fmt.Errorf("位置 %d: IN 列表只支持字符串常量", token.pos+1)
+			}
+			values[token.value] = struct{}{}
+			if p.acceptOp(")") {
+				break
+			}
+			if !p.acceptOp(",") {
+				return nil, p.errorf("IN 列表缺少逗号或右括号")
+			}
+		}
+		return sqlInExpr{operand: left, values: values, negate: negate}, nil
+	case p.peek().kind == "keyword" && (p.peek().value == "CONTAINS" || p.peek().value == "STARTSWITH" || p.peek().value == "ENDSWITH"):
+		op := p.next().value
+		token := p.next()
+		if token.kind != "string" {
+			return nil, fmt.Errorf("位置 %d: %s 后应为字符串常量", token.pos+1, op)
+		}
+		return sqlStringMatchExpr{op: op, operand: left, pattern: token.value, negate: negate}, nil
+	case negate:
+		return nil, p.errorf("NOT 后应为 BETWEEN、IN、CONTAINS、STARTSWITH 或 ENDSWITH")
+	}
+
+	token = p.next()
+	if token.kind != "op" {
+		return nil, fmt.Errorf("位置 %d: 应为比较运算符", token.pos+1)
+	}
+	switch token.value {
+	case "=", "<>", ">", ">=", "<", "<=":
+	default:
+		return nil, fmt.Errorf("位置 %d: 应为比较运算符", token.pos+1)
+	}
+
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if left.property == "" && right.property == "" {
+		return nil, fmt.Errorf("位置 %d: 比较两侧不能都是常量", token.pos+1)
+	}
+	if token.value != "=" && token.value != "<>" && (left.kind == sqlValueString && left.property == "" || right.kind == sqlValueString && right.property == "" || left.kind == sqlValueBool || right.kind == sqlValueBool) {
+		return nil, fmt.Errorf("位置 %d: 字符串与布尔值只支持 = 和 <> 比较", token.pos+1)
+	}
+	return sqlCompareExpr{op: token.value, left: left, right: right}, nil
+}
+
+func (p *sqlParser) parseOperand() (sqlOperand, error) {
+	token := p.next()
+	switch token.kind {
+	case "ident":
+		return sqlOperand{property: token.value, kind: sqlValueString}, nil
+	case "string":
+		return sqlOperand{kind: sqlValueString, text: token.value}, nil
+	case "number":
+		number, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return sqlOperand{}, fmt.Errorf("位置 %d: 无效的数字 %s", token.pos+1, token.text)
+		}
+		return sqlOperand{kind: sqlValueNumber, text: token.value, number: number}, nil
+	case "keyword":
+		if token.value == "TRUE" || token.value == "FALSE" {
+			return sqlOperand{kind: sqlValueBool, text: strings.ToLower(token.value)}, nil
+		}
+	}
+	if token.kind == "eof" {
+		return sqlOperand{}, fmt.Errorf("表达式不完整: 缺少操作数")
+	}
+	return sqlOperand{}, fmt.Errorf("位置 %d: 应为属性名或常量，实际为 %q", token.pos+1, token.text)
+}
+
+func (p *sqlParser) parseNumber() (float64, error) {
+	token := p.next()
+	if token.kind != "number" {
+		return 0, fmt.Errorf("位置 %d: BETWEEN 只支持数字常量", token.pos+1)
+	}
+	number, err := strconv.ParseFloat(token.value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("位置 %d: 无效的数字 %s", token.pos+1, token.text)
+	}
+	return number, nil
+}
+
+// parseTagExpression 解析 Tag 订阅表达式，返回 nil 表示订阅全部（* 或空）
+func parseTagExpression(expression string) []string {
+	expression = strings.TrimSpace(expression)
+	if expression == "" || expression == "*" {
+		return nil
+	}
+
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Split(expression, "||") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags[tag] = struct{}{}
+		}
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(tags))
+	for tag := range tags {
+		result = append(result, tag)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"rocket-leaf/internal/model"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+func TestSQLFilterEval(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		properties map[string]string
+		want       sqlTruth
+	}{
+		{name: "字符串相等", expression: "region = 'hz'", properties: map[string]string{"region": "hz"}, want: sqlTrue},
+		{name: "字符串不等", expression: "region <> 'hz'", properties: map[string]string{"region": "sh"}, want: sqlTrue},
+		{name: "!= 等价于 <>", expression: "region != 'hz'", properties: map[string]string{"region": "hz"}, want: sqlFalse},
+		{name: "属性不存在为 UNKNOWN", expression: "region = 'hz'", properties: map[string]string{}, want: sqlUnknown},
+		{name: "数值比较", expression: "amount > 100", properties: map[string]string{"amount": "250"}, want: sqlTrue},
+		{name: "数值比较按数字而非字符串", expression: "amount < 100", properties: map[string]string{"amount": "99.5"}, want: sqlTrue},
+		{name: "数值相等忽略格式", expression: "amount = 10", properties: map[string]string{"amount": "10.0"}, want: sqlTrue},
+		{name: "负数", expression: "delta >= -5", properties: map[string]string{"delta": "-3"}, want: sqlTrue},
+		{name: "长整型后缀", expression: "id <= 10L", properties: map[string]string{"id": "10"}, want: sqlTrue},
+		{name: "非数字属性为 FALSE", expression: "amount > 100", properties: map[string]string{"amount": "abc"}, want: sqlFalse},
+		{name: "BETWEEN", expression: "amount BETWEEN 1 AND 3", properties: map[string]string{"amount": "3"}, want: sqlTrue},
+		{name: "NOT BETWEEN", expression: "amount NOT BETWEEN 1 AND 3", properties: map[string]string{"amount": "2"}, want: sqlFalse},
+		{name: "BETWEEN 属性不存在", expression: "amount BETWEEN 1 AND 3", properties: map[string]string{}, want: sqlUnknown},
+		{name: "IN", expression: "region IN ('hz', 'sh')", properties: map[string]string{"region": "sh"}, want: sqlTrue},
+		{name: "NOT IN", expression: "region NOT IN ('hz')", properties: map[string]string{"region": "hz"}, want: sqlFalse},
+		{name: "IN 属性不存在", expression: "region IN ('hz')", properties: map[string]string{}, want: sqlUnknown},
+		{name: "IS NULL", expression: "region IS NULL", properties: map[string]string{}, want: sqlTrue},
+		{name: "IS NOT NULL", expression: "region IS NOT NULL", properties: map[string]string{}, want: sqlFalse},
+		{name: "CONTAINS", expression: "name CONTAINS 'bc'", properties: map[string]string{"name": "abcd"}, want: sqlTrue},
+		{name: "STARTSWITH", expression: "name STARTSWITH 'ab'", properties: map[string]string{"name": "abcd"}, want: sqlTrue},
+		{name: "ENDSWITH", expression: "name ENDSWITH 'x'", properties: map[string]string{"name": "abcd"}, want: sqlFalse},
+		{name: "NOT CONTAINS", expression: "name NOT CONTAINS 'z'", properties: map[string]string{"name": "abcd"}, want: sqlTrue},
+		{name: "转义单引号", expression: "name = 'it''s'", properties: map[string]string{"name": "it's"}, want: sqlTrue},
+		{name: "布尔值忽略大小写", expression: "vip = TRUE", properties: map[string]string{"vip": "TRUE"}, want: sqlTrue},
+		{name: "布尔常量", expression: "TRUE", properties: map[string]string{}, want: sqlTrue},
+		{name: "关键字不区分大小写", expression: "region in ('hz') and amount between 1 and 2", properties: map[string]string{"region": "hz", "amount": "1"}, want: sqlTrue},
+		{name: "FALSE AND UNKNOWN", expression: "region = 'hz' AND amount > 1", properties: map[string]string{"region": "sh"}, want: sqlFalse},
+		{name: "TRUE AND UNKNOWN", expression: "region = 'hz' AND amount > 1", properties: map[string]string{"region": "hz"}, want: sqlUnknown},
+		{name: "TRUE OR UNKNOWN", expression: "region = 'hz' OR amount > 1", properties: map[string]string{"region": "hz"}, want: sqlTrue},
+		{name: "FALSE OR UNKNOWN", expression: "region = 'hz' OR amount > 1", properties: map[string]string{"region": "sh"}, want: sqlUnknown},
+		{name: "NOT UNKNOWN", expression: "NOT (region = 'hz')", properties: map[string]string{}, want: sqlUnknown},
+		{name: "AND 优先于 OR", expression: "a = '1' OR a = '2' AND b = '3'", properties: map[string]string{"a": "1", "b": "0"}, want: sqlTrue},
+		{name: "括号改变优先级", expression: "(a = '1' OR a = '2') AND b = '3'", properties: map[string]string{"a": "1", "b": "0"}, want: sqlFalse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, _, err := parseSQLFilter(tt.expression)
+			if err != nil {
+				t.Fatalf("解析 %q 失败: %v", tt.expression, err)
+			}
+			if got := expr.eval(newSQLEvalContext(tt.properties)); got != tt.want {
+				t.Errorf("%q 求值 = %s, 期望 %s", tt.expression, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSQLFilterProperties(t *testing.T) {
+	_, properties, err := parseSQLFilter("b = 'x' AND a > 1 OR b IS NULL")
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(properties, want) {
+		t.Errorf("引用的属性 = %v, 期望 %v", properties, want)
+	}
+}
+
+func TestParseSQLFilterErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    string
+	}{
+		{name: "空表达式", expression: "  ", wantErr: "不能为空"},
+		{name: "缺少结束引号", expression: "a = 'x", wantErr: "缺少结束引号"},
+		{name: "无法识别的字符", expression: "a = 1 & b = 2", wantErr: "无法识别的字符"},
+		{name: "缺少右括号", expression: "(a = 1", wantErr: "缺少右括号"},
+		{name: "缺少操作数", expression: "a =", wantErr: "缺少操作数"},
+		{name: "多余内容", expression: "a = 1 b", wantErr: "多余的内容"},
+		{name: "两侧都是常量", expression: "1 = 1", wantErr: "不能都是常量"},
+		{name: "字符串不支持大小比较", expression: "a > 'x'", wantErr: "只支持 = 和 <>"},
+		{name: "BETWEEN 缺少 AND", expression: "a BETWEEN 1 2", wantErr: "缺少 AND"},
+		{name: "BETWEEN 只支持数字", expression: "a BETWEEN 'x' AND 2", wantErr: "只支持数字常量"},
+		{name: "IN 只支持字符串", expression: "a IN (1)", wantErr: "只支持字符串常量"},
+		{name: "IS 后应为 NULL", expression: "a IS 1", wantErr: "应为 NULL"},
+		{name: "NOT 后的谓词", expression: "a NOT = 1", wantErr: "NOT 后应为"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := parseSQLFilter(tt.expression)
+			if err == nil {
+				t.Fatalf("%q 期望解析失败", tt.expression)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%q 错误信息 = %q, 期望包含 %q", tt.expression, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMessageFilterTags(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		tag        string
+		want       sqlTruth
+	}{
+		{name: "订阅全部", expression: "*", tag: "TagA", want: sqlTrue},
+		{name: "空表达式订阅全部", expression: "", tag: "", want: sqlTrue},
+		{name: "命中其中一个标签", expression: "TagA || TagB", tag: "TagB", want: sqlTrue},
+		{name: "标签不在订阅中", expression: "TagA || TagB", tag: "TagC", want: sqlFalse},
+		{name: "无标签消息只匹配 *", expression: "TagA", tag: "", want: sqlFalse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, _, err := newMessageFilter(model.FilterTag, tt.expression)
+			if err != nil {
+				t.Fatalf("解析失败: %v", err)
+			}
+			msg := &admin.MessageExt{Properties: map[string]string{}}
+			if tt.tag != "" {
+				msg.Properties[propertyTags] = tt.tag
+			}
+			got, reason := filter.match(msg)
+			if got != tt.want {
+				t.Errorf("匹配结果 = %s, 期望 %s", got, tt.want)
+			}
+			if got != sqlTrue && reason == "" {
+				t.Errorf("未命中时应返回原因")
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// 5.x 客户端消息ID的版本号与时间起点
+const (
+	messageIDV5Version = 0x01
+	messageIDV5Length  = 17
+)
+
+var messageIDV5Epoch = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// DecodeMessageID 解析消息ID或唯一键中编码的信息
+func (s *MessageService) DecodeMessageID(msgID string) (*model.MessageIDInfo, error) {
+	msgID = strings.TrimSpace(msgID)
+	if msgID == "" {
+		return nil, fmt.Errorf("解析消息ID失败: 消息ID不能为空")
+	}
+
+	info := decodeMessageID(msgID, time.Now())
+	return &info, nil
+}
+
+// decodeMessageID 按长度与字段取值识别消息ID类型并解析
+// 偏移消息ID：IP(4/16) + 端口(4) + CommitLog 偏移(8)；
+// 4.x 唯一键：IP(4/16) + PID(2) + ClassLoader 哈希(4) + 距当月开始的毫秒数(4) + 计数器(2)；
+// 5.x 消息ID：版本(1) + MAC(6) + PID(2) + 距 2021-01-01 的秒数(4) + 序号(4)。
+// IPv4 下偏移消息ID与唯一键长度相同，端口字段高 16 位为 0 且端口有效时按偏移消息ID处理。
+func decodeMessageID(msgID string, now time.Time) model.MessageIDInfo {
+	info := model.MessageIDInfo{Input: msgID, Type: model.MessageIDUnknown, Notes: make([]string, 0)}
+
+	data, err := hex.DecodeString(msgID)
+	if err != nil {
+		info.Notes = append(info.Notes, "不是十六进制字符串")
+		return info
+	}
+
+	if len(data) == messageIDV5Length && data[0] == messageIDV5Version {
+		info.Type = model.MessageIDV5
+		info.MAC = net.HardwareAddr(data[1:7]).String()
+		info.PID = int(binary.BigEndian.Uint16(data[7:9]))
+		seconds := int64(binary.BigEndian.Uint32(data[9:13]))
+		info.Timestamp = messageIDV5Epoch.Add(time.Duration(seconds) * time.Second).UnixMilli()
+		info.Time = formatTimestamp(info.Timestamp)
+		info.Counter = int64(binary.BigEndian.Uint32(data[13:17]))
+		info.Notes = append(info.Notes, "5.x 消息ID不包含存储位置，需结合 Topic 查询")
+		return info
+	}
+
+	var ipLen int
+	switch len(data) {
+	case 16:
+		ipLen = net.IPv4len
+	case 28:
+		ipLen = net.IPv6len
+	default:
+		info.Notes = append(info.Notes, fmt.Sprintf("长度 %d 不符合任何已知的消息ID格式", len(msgID)))
+		return info
+	}
+
+	ip := net.IP(data[:ipLen])
+	port := binary.BigEndian.Uint32(data[ipLen : ipLen+4])
+	offset := int64(binary.BigEndian.Uint64(data[ipLen+4:]))
+	if port > 0 && port <= 65535 && offset >= 0 {
+		info.Type = model.MessageIDOffset
+		info.StoreHost = net.JoinHostPort(ip.String(), strconv.Itoa(int(port)))
+		info.CommitLogOffset = offset
+		info.Notes = append(info.Notes, "可直接按存储节点与 CommitLog 偏移查询，无需 Topic")
+		return info
+	}
+
+	info.Type = model.MessageIDUnique
+	info.ClientIP = ip.String()
+	info.PID = int(binary.BigEndian.Uint16(data[ipLen : ipLen+2]))
+	info.ClassLoaderHash = hex.EncodeToString(data[ipLen+2 : ipLen+6])
+	elapsed := int64(int32(binary.BigEndian.Uint32(data[ipLen+6 : ipLen+10])))
+	info.Counter = int64(binary.BigEndian.Uint16(data[ipLen+10 : ipLen+12]))
+
+	// 唯一键只记录距生产者启动当月开始的毫秒数，按当前月推算，晚于当前时间时退回上一个月
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	bornTime := monthStart.Add(time.Duration(elapsed) * time.Millisecond)
+	if bornTime.After(now) {
+		bornTime = monthStart.AddDate(0, -1, 0).Add(time.Duration(elapsed) * time.Millisecond)
+	}
+	info.Timestamp = bornTime.UnixMilli()
+	info.Time = formatTimestamp(info.Timestamp)
+	info.Notes = append(info.Notes,
+		"生产时间按生产者启动当月推算，生产者跨月运行时可能存在偏差",
+		"唯一键不包含存储位置，需结合 Topic 查询")
+	return info
+}
+
+// viewMessageByOffsetID 按偏移消息ID中的存储节点与 CommitLog 偏移直接查询消息
+func viewMessageByOffsetID(client *admin.Client, info model.MessageIDInfo) (*admin.MessageExt, error) {
+	var msg *admin.MessageExt
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		found, callErr := retryClient.ViewMessageByOffset(ctx, info.StoreHost, info.CommitLogOffset)
+		if callErr != nil {
+			return callErr
+		}
+		msg = found
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
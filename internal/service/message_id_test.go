@@ -0,0 +1,101 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"rocket-leaf/internal/model"
+)
+
+func TestDecodeMessageID(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	monthStart := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		msgID string
+		now   time.Time
+		want  model.MessageIDInfo
+	}{
+		{
+			name:  "IPv4 偏移消息ID",
+			msgID: "C0A8000A00002A9F000000000001E240",
+			want:  model.MessageIDInfo{Type: model.MessageIDOffset, StoreHost: "192.168.0.10:10911", CommitLogOffset: 123456},
+		},
+		{
+			name:  "小写十六进制",
+			msgID: "c0a8000a00002a9f000000000001e240",
+			want:  model.MessageIDInfo{Type: model.MessageIDOffset, StoreHost: "192.168.0.10:10911", CommitLogOffset: 123456},
+		},
+		{
+			name:  "IPv6 偏移消息ID",
+			msgID: "0000000000000000000000000000000100002A9F0000000000000010",
+			want:  model.MessageIDInfo{Type: model.MessageIDOffset, StoreHost: "[::1]:10911", CommitLogOffset: 16},
+		},
+		{
+			name:  "4.x 唯一键",
+			msgID: "0A0000011234ABCDEF0105265C000007",
+			want: model.MessageIDInfo{
+				Type:            model.MessageIDUnique,
+				ClientIP:        "10.0.0.1",
+				PID:             0x1234,
+				ClassLoaderHash: "abcdef01",
+				Timestamp:       monthStart.Add(24 * time.Hour).UnixMilli(),
+				Counter:         7,
+			},
+		},
+		{
+			name:  "唯一键晚于当前时间时按上个月推算",
+			msgID: "0A0000011234ABCDEF010A4CB8000007",
+			now:   time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+			want: model.MessageIDInfo{
+				Type:            model.MessageIDUnique,
+				ClientIP:        "10.0.0.1",
+				PID:             0x1234,
+				ClassLoaderHash: "abcdef01",
+				Timestamp:       time.Date(2026, 9, 3, 0, 0, 0, 0, time.UTC).UnixMilli(),
+				Counter:         7,
+			},
+		},
+		{
+			name:  "5.x 消息ID",
+			msgID: "010A1B2C3D4E5F04D20001518000000009",
+			want: model.MessageIDInfo{
+				Type:      model.MessageIDV5,
+				MAC:       "0a:1b:2c:3d:4e:5f",
+				PID:       1234,
+				Timestamp: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC).UnixMilli(),
+				Counter:   9,
+			},
+		},
+		{name: "不是十六进制", msgID: "not-a-message-id", want: model.MessageIDInfo{Type: model.MessageIDUnknown}},
+		{name: "长度不符", msgID: "C0A8000A", want: model.MessageIDInfo{Type: model.MessageIDUnknown}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := tt.now
+			if at.IsZero() {
+				at = now
+			}
+			got := decodeMessageID(tt.msgID, at)
+			if got.Input != tt.msgID {
+				t.Errorf("Input = %q, 期望 %q", got.Input, tt.msgID)
+			}
+			if len(got.Notes) == 0 {
+				t.Errorf("应返回解析说明")
+			}
+
+			tt.want.Input = got.Input
+			tt.want.Time = got.Time
+			tt.want.Notes = got.Notes
+			if got.Time == "" && tt.want.Timestamp != 0 {
+				t.Errorf("Time 不应为空")
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("解析结果 = %+v\n期望 %+v", got, tt.want)
+			}
+		})
+	}
+}
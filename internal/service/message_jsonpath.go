@@ -0,0 +1,147 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPathStep JSONPath 中的一步：字段名、数组下标或通配符
+type jsonPathStep struct {
+	field    string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// parseJSONPath 解析 JSONPath 子集：$、.field、['field']、[n]、[*]、.*
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, fmt.Errorf("JSONPath 不能为空")
+	}
+	if strings.HasPrefix(path, "$") {
+		path = path[1:]
+	} else if !strings.HasPrefix(path, ".") && !strings.HasPrefix(path, "[") {
+		path = "." + path
+	}
+
+	steps := make([]jsonPathStep, 0)
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			i++
+			if i < len(path) && path[i] == '.' {
+				return nil, fmt.Errorf("JSONPath 不支持递归下降 ..")
+			}
+			start := i
+			for i < len(path) && path[i] != '.' && path[i] != '[' {
+				i++
+			}
+			field := path[start:i]
+			if field == "" {
+				return nil, fmt.Errorf("JSONPath 位置 %d 缺少字段名", start+1)
+			}
+			if field == "*" {
+				steps = append(steps, jsonPathStep{wildcard: true})
+			} else {
+				steps = append(steps, jsonPathStep{field: field})
+			}
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("JSONPath 位置 %d 缺少 ]", i+1)
+			}
+			inner := strings.TrimSpace(path[i+1 : i+end])
+			i += end + 1
+
+			switch {
+			case inner == "*":
+				steps = append(steps, jsonPathStep{wildcard: true})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				steps = append(steps, jsonPathStep{field: inner[1 : len(inner)-1]})
+			default:
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("JSONPath 不支持的下标 [%s]", inner)
+				}
+				steps = append(steps, jsonPathStep{index: index, isIndex: true})
+			}
+		default:
+			return nil, fmt.Errorf("JSONPath 位置 %d 存在无法识别的字符 %q", i+1, path[i])
+		}
+	}
+	return steps, nil
+}
+
+// evalJSONPath 在 JSON 文档上求值，返回所有匹配到的节点
+func evalJSONPath(document interface{}, steps []jsonPathStep) []interface{} {
+	current := []interface{}{document}
+	for _, step := range steps {
+		next := make([]interface{}, 0, len(current))
+		for _, node := range current {
+			switch typed := node.(type) {
+			case map[string]interface{}:
+				if step.wildcard {
+					for _, key := range sortedKeys(typed) {
+						next = append(next, typed[key])
+					}
+				} else if value, ok := typed[step.field]; ok && !step.isIndex {
+					next = append(next, value)
+				}
+			case []interface{}:
+				switch {
+				case step.wildcard:
+					next = append(next, typed...)
+				case step.isIndex:
+					index := step.index
+					if index < 0 {
+						index += len(typed)
+					}
+					if index >= 0 && index < len(typed) {
+						next = append(next, typed[index])
+					}
+				}
+			}
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
+
+// jsonValueText 将 JSON 节点转换为用于比较的文本，标量取其字面值，对象与数组取紧凑 JSON
+func jsonValueText(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	case bool:
+		return strconv.FormatBool(typed)
+	default:
+		data, err := json.Marshal(typed)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+}
+
+// decodeJSONDocument 解析 JSON 文档，数字保留原始字面值
+func decodeJSONDocument(body []byte) (interface{}, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, false
+	}
+	return document, true
+}
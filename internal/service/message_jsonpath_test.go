@@ -0,0 +1,128 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"rocket-leaf/internal/model"
+)
+
+const jsonPathTestDocument = `{
+	"order": {"id": 1001, "amount": 12.50, "paid": true, "coupon": null},
+	"items": [
+		{"sku": "A-1", "qty": 2},
+		{"sku": "B-2", "qty": 1}
+	],
+	"tags": ["new", "vip"],
+	"meta data": {"source": "app"}
+}`
+
+func TestEvalJSONPath(t *testing.T) {
+	document, ok := decodeJSONDocument([]byte(jsonPathTestDocument))
+	if !ok {
+		t.Fatalf("解析测试文档失败")
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want []string
+	}{
+		{name: "根节点下的字段", path: "$.order.id", want: []string{"1001"}},
+		{name: "省略 $", path: "order.id", want: []string{"1001"}},
+		{name: "数字保留原始字面值", path: "$.order.amount", want: []string{"12.50"}},
+		{name: "布尔值", path: "$.order.paid", want: []string{"true"}},
+		{name: "null", path: "$.order.coupon", want: []string{"null"}},
+		{name: "数组下标", path: "$.items[1].sku", want: []string{"B-2"}},
+		{name: "负数下标从末尾计数", path: "$.tags[-1]", want: []string{"vip"}},
+		{name: "数组通配符", path: "$.items[*].sku", want: []string{"A-1", "B-2"}},
+		{name: "对象通配符按键排序", path: "$.items[0].*", want: []string{"2", "A-1"}},
+		{name: "括号字段名", path: "$['meta data'].source", want: []string{"app"}},
+		{name: "双引号字段名", path: `$["meta data"]["source"]`, want: []string{"app"}},
+		{name: "对象取紧凑 JSON", path: "$.items[0]", want: []string{`{"qty":2,"sku":"A-1"}`}},
+		{name: "字段不存在", path: "$.order.missing", want: nil},
+		{name: "下标越界", path: "$.tags[5]", want: nil},
+		{name: "对对象使用下标", path: "$.order[0]", want: nil},
+		{name: "对标量取字段", path: "$.order.id.value", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps, err := parseJSONPath(tt.path)
+			if err != nil {
+				t.Fatalf("解析 %q 失败: %v", tt.path, err)
+			}
+			var got []string
+			for _, node := range evalJSONPath(document, steps) {
+				got = append(got, jsonValueText(node))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%q 结果 = %v, 期望 %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJSONPathErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{name: "空路径", path: " ", wantErr: "不能为空"},
+		{name: "递归下降", path: "$..id", wantErr: "递归下降"},
+		{name: "缺少字段名", path: "$.order.", wantErr: "缺少字段名"},
+		{name: "缺少右括号", path: "$.items[0", wantErr: "缺少 ]"},
+		{name: "不支持的下标", path: "$.items[?(@.qty>1)]", wantErr: "不支持的下标"},
+		{name: "无法识别的字符", path: "$order", wantErr: "无法识别的字符"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseJSONPath(tt.path)
+			if err == nil {
+				t.Fatalf("%q 期望解析失败", tt.path)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%q 错误信息 = %q, 期望包含 %q", tt.path, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJSONPathBodyMatcher(t *testing.T) {
+	body := []byte(jsonPathTestDocument)
+	tests := []struct {
+		name string
+		req  model.BodySearchRequest
+		want bool
+	}{
+		{name: "路径存在", req: model.BodySearchRequest{Mode: model.SearchJSONPath, Pattern: "$.order.coupon"}, want: true},
+		{name: "路径不存在", req: model.BodySearchRequest{Mode: model.SearchJSONPath, Pattern: "$.order.refund"}, want: false},
+		{name: "任一节点取值相等", req: model.BodySearchRequest{Mode: model.SearchJSONPath, Pattern: "$.items[*].sku", JSONValue: "B-2", CaseSensitive: true}, want: true},
+		{name: "区分大小写", req: model.BodySearchRequest{Mode: model.SearchJSONPath, Pattern: "$.items[*].sku", JSONValue: "b-2", CaseSensitive: true}, want: false},
+		{name: "不区分大小写", req: model.BodySearchRequest{Mode: model.SearchJSONPath, Pattern: "$.items[*].sku", JSONValue: "b-2"}, want: true},
+		{name: "数字按字面值比较", req: model.BodySearchRequest{Mode: model.SearchJSONPath, Pattern: "$.order.amount", JSONValue: "12.5"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := newBodyMatcher(tt.req)
+			if err != nil {
+				t.Fatalf("创建匹配函数失败: %v", err)
+			}
+			if got := matcher(body); got != tt.want {
+				t.Errorf("匹配结果 = %v, 期望 %v", got, tt.want)
+			}
+		})
+	}
+
+	matcher, err := newBodyMatcher(model.BodySearchRequest{Mode: model.SearchJSONPath, Pattern: "$.order"})
+	if err != nil {
+		t.Fatalf("创建匹配函数失败: %v", err)
+	}
+	if matcher([]byte("not json")) {
+		t.Errorf("非 JSON 消息体不应命中")
+	}
+}
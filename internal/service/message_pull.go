@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// pullBatchSize 单次拉取的最大消息数
+const pullBatchSize = 32
+
+// messageQueueRef 可读队列及其所在的 Master 地址
+type messageQueueRef struct {
+	mq         admin.MessageQueue
+	brokerAddr string
+}
+
+// fetchTopicQueues 根据路由信息获取 Topic 的全部可读队列，按 BrokerName、QueueID 排序
+func fetchTopicQueues(client *admin.Client, topic string) ([]messageQueueRef, error) {
+	result := make([]messageQueueRef, 0)
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		routeInfo, callErr := retryClient.ExamineTopicRouteInfo(ctx, topic)
+		if callErr != nil {
+			return callErr
+		}
+
+		masterAddrs := make(map[string]string, len(routeInfo.BrokerDatas))
+		for _, brokerData := range routeInfo.BrokerDatas {
+			if brokerData == nil {
+				continue
+			}
+			if addr, ok := brokerData.BrokerAddrs["0"]; ok {
+				masterAddrs[brokerData.BrokerName] = addr
+			}
+		}
+
+		tmpResult := make([]messageQueueRef, 0)
+		for _, queueData := range routeInfo.QueueDatas {
+			brokerAddr, ok := masterAddrs[queueData.BrokerName]
+			if !ok {
+				continue
+			}
+			for queueID := 0; queueID < queueData.ReadQueueNums; queueID++ {
+				tmpResult = append(tmpResult, messageQueueRef{
+					mq: admin.MessageQueue{
+						Topic:      topic,
+						BrokerName: queueData.BrokerName,
+						QueueId:    queueID,
+					},
+					brokerAddr: brokerAddr,
+				})
+			}
+		}
+
+		result = tmpResult
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].mq.BrokerName != result[j].mq.BrokerName {
+			return result[i].mq.BrokerName < result[j].mq.BrokerName
+		}
+		return result[i].mq.QueueId < result[j].mq.QueueId
+	})
+	return result, nil
+}
+
+// fetchQueueOffsetRange 获取队列的最小、最大位点
+func fetchQueueOffsetRange(client *admin.Client, queue messageQueueRef) (int64, int64, error) {
+	var minOffset, maxOffset int64
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		minValue, callErr := retryClient.MinOffset(ctx, queue.mq)
+		if callErr != nil {
+			return callErr
+		}
+		maxValue, callErr := retryClient.MaxOffset(ctx, queue.mq)
+		if callErr != nil {
+			return callErr
+		}
+
+		minOffset, maxOffset = minValue, maxValue
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return minOffset, maxOffset, nil
+}
+
+// searchQueueOffset 按存储时间戳查找队列位点
+func searchQueueOffset(client *admin.Client, queue messageQueueRef, timestamp int64) (int64, error) {
+	var offset int64
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		value, callErr := retryClient.SearchOffset(ctx, queue.mq, timestamp)
+		if callErr != nil {
+			return callErr
+		}
+		offset = value
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return offset, nil
+}
+
+// resolveQueueTimeRange 将时间范围换算为队列位点区间 [start, end)，时间为 0 表示不限
+func resolveQueueTimeRange(client *admin.Client, queue messageQueueRef, startTime int64, endTime int64) (int64, int64, error) {
+	minOffset, maxOffset, err := fetchQueueOffsetRange(client, queue)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start, end := minOffset, maxOffset
+	if startTime > 0 {
+		if start, err = searchQueueOffset(client, queue, startTime); err != nil {
+			return 0, 0, err
+		}
+	}
+	if endTime > 0 {
+		if end, err = searchQueueOffset(client, queue, endTime); err != nil {
+			return 0, 0, err
+		}
+		// searchOffset 返回第一条存储时间不早于 endTime 的消息位点，多拉一条以包含边界消息，超出部分由时间过滤剔除
+		end++
+	}
+
+	if start < minOffset {
+		start = minOffset
+	}
+	if end > maxOffset {
+		end = maxOffset
+	}
+	return start, end, nil
+}
+
+// pullQueueMessages 从队列指定位点拉取一批消息
+func pullQueueMessages(ctx context.Context, client *admin.Client, queue messageQueueRef, offset int64, maxNums int) (*admin.PullResult, error) {
+	var result *admin.PullResult
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		callCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		pullResult, callErr := retryClient.PullMessage(callCtx, queue.brokerAddr, queue.mq, offset, maxNums)
+		if callErr != nil {
+			return callErr
+		}
+		result = pullResult
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// scanQueueRange 顺序扫描队列位点区间 [start, end) 内的消息
+// visit 返回 false 时停止扫描；返回下一次扫描的起始位点。
+func scanQueueRange(ctx context.Context, client *admin.Client, queue messageQueueRef, start int64, end int64, visit func(*admin.MessageExt) bool) (int64, error) {
+	offset := start
+	for offset < end {
+		if err := ctx.Err(); err != nil {
+			return offset, err
+		}
+
+		batch := pullBatchSize
+		if remaining := end - offset; remaining < int64(batch) {
+			batch = int(remaining)
+		}
+
+		pullResult, err := pullQueueMessages(ctx, client, queue, offset, batch)
+		if err != nil {
+			return offset, fmt.Errorf("拉取队列 %s-%d 消息失败: %w", queue.mq.BrokerName, queue.mq.QueueId, err)
+		}
+
+		switch pullResult.PullStatus {
+		case admin.PullFound:
+		case admin.PullNoMatchedMsg, admin.PullOffsetIllegal:
+			if pullResult.NextBeginOffset <= offset {
+				return offset, nil
+			}
+			offset = pullResult.NextBeginOffset
+			continue
+		default:
+			return offset, nil
+		}
+
+		for _, msg := range pullResult.MsgFoundList {
+			if msg == nil || msg.QueueOffset < offset {
+				continue
+			}
+			if msg.QueueOffset >= end {
+				return end, nil
+			}
+			if !visit(msg) {
+				return msg.QueueOffset + 1, nil
+			}
+		}
+
+		if pullResult.NextBeginOffset <= offset {
+			return offset, nil
+		}
+		offset = pullResult.NextBeginOffset
+	}
+
+	return offset, nil
+}
+
+// topicScanOptions Topic 扫描条件
+type topicScanOptions struct {
+	topic     string
+	startTime int64 // 开始时间戳(毫秒)，0 表示不限
+	endTime   int64 // 结束时间戳(毫秒)，0 表示不限
+	key       string
+	scanLimit int64 // 最多扫描的消息数，0 表示不限
+}
+
+// scanTopicMessages 依次扫描 Topic 各队列中符合时间范围与 Key 条件的消息
+// visit 返回 false 时停止扫描；返回实际扫描（含未命中）的消息数。
+func scanTopicMessages(ctx context.Context, client *admin.Client, opts topicScanOptions, visit func(*admin.MessageExt) bool) (int64, error) {
+	queues, err := fetchTopicQueues(client, opts.topic)
+	if err != nil {
+		return 0, fmt.Errorf("获取 Topic %s 路由失败: %w", opts.topic, err)
+	}
+
+	var scanned int64
+	stopped := false
+	for _, queue := range queues {
+		start, end, err := resolveQueueTimeRange(client, queue, opts.startTime, opts.endTime)
+		if err != nil {
+			return scanned, fmt.Errorf("获取队列 %s-%d 位点失败: %w", queue.mq.BrokerName, queue.mq.QueueId, err)
+		}
+
+		_, err = scanQueueRange(ctx, client, queue, start, end, func(msg *admin.MessageExt) bool {
+			if opts.scanLimit > 0 && scanned >= opts.scanLimit {
+				stopped = true
+				return false
+			}
+			scanned++
+
+			if opts.startTime > 0 && msg.StoreTimestamp < opts.startTime {
+				return true
+			}
+			if opts.endTime > 0 && msg.StoreTimestamp > opts.endTime {
+				return true
+			}
+			if opts.key != "" && !messageMatchesKey(msg, opts.key) {
+				return true
+			}
+			if !visit(msg) {
+				stopped = true
+				return false
+			}
+			return true
+		})
+		if err != nil {
+			return scanned, err
+		}
+		if stopped {
+			break
+		}
+	}
+
+	return scanned, nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const (
+	defaultQueueBrowseCount = 32
+	maxQueueBrowseCount     = 1000
+)
+
+// BrowseQueueMessages 按位点顺序浏览单个队列的消息，返回前后翻页的游标
+func (s *MessageService) BrowseQueueMessages(params model.QueueBrowseParams) (*model.QueueBrowseResult, error) {
+	topic := strings.TrimSpace(params.Topic)
+	brokerName := strings.TrimSpace(params.BrokerName)
+	if topic == "" || brokerName == "" {
+		return nil, fmt.Errorf("浏览队列消息失败: Topic 和 Broker 不能为空")
+	}
+
+	count := params.Count
+	if count <= 0 {
+		count = defaultQueueBrowseCount
+	}
+	if count > maxQueueBrowseCount {
+		count = maxQueueBrowseCount
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	queue, err := findTopicQueue(client, topic, brokerName, params.QueueID)
+	if err != nil {
+		return nil, err
+	}
+
+	minOffset, maxOffset, err := fetchQueueOffsetRange(client, queue)
+	if err != nil {
+		return nil, fmt.Errorf("获取队列位点失败: %w", err)
+	}
+
+	start := params.Offset
+	if start < 0 {
+		start = maxOffset - int64(count)
+	}
+	if start < minOffset {
+		start = minOffset
+	}
+	if start > maxOffset {
+		start = maxOffset
+	}
+	end := start + int64(count)
+	if end > maxOffset {
+		end = maxOffset
+	}
+
+	result := &model.QueueBrowseResult{
+		Topic:       topic,
+		BrokerName:  brokerName,
+		QueueID:     params.QueueID,
+		MinOffset:   minOffset,
+		MaxOffset:   maxOffset,
+		StartOffset: start,
+		Messages:    make([]*model.MessageItem, 0, end-start),
+	}
+
+	next, err := scanQueueRange(context.Background(), client, queue, start, end, func(msg *admin.MessageExt) bool {
+		result.Messages = append(result.Messages, s.toMessageItem(msg))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.NextOffset = next
+	result.HasNext = next < maxOffset
+	result.PrevOffset = start - int64(count)
+	if result.PrevOffset < minOffset {
+		result.PrevOffset = minOffset
+	}
+	result.HasPrev = start > minOffset
+
+	return result, nil
+}
+
+// findTopicQueue 在 Topic 路由中查找指定队列
+func findTopicQueue(client *admin.Client, topic string, brokerName string, queueID int) (messageQueueRef, error) {
+	queues, err := fetchTopicQueues(client, topic)
+	if err != nil {
+		return messageQueueRef{}, fmt.Errorf("获取 Topic %s 路由失败: %w", topic, err)
+	}
+
+	for _, queue := range queues {
+		if queue.mq.BrokerName == brokerName && queue.mq.QueueId == queueID {
+			return queue, nil
+		}
+	}
+	return messageQueueRef{}, fmt.Errorf("队列不存在: %s-%s-%d", topic, brokerName, queueID)
+}
@@ -0,0 +1,408 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	rmq "github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+)
+
+const (
+	replayLogDirName        = "replay-logs"
+	defaultReplayRatePerSec = 20
+	maxReplayRatePerSec     = 1000
+	maxReplayPushedItems    = 1000
+	maxConcurrentReplays    = 3
+	replayReportInterval    = 500 * time.Millisecond
+)
+
+// messageReplay 一次后台运行的消息回放
+type messageReplay struct {
+	id          string
+	req         model.MessageReplayRequest
+	records     []model.ExportedMessage
+	producer    rmq.Producer
+	logFile     *os.File
+	ratePerSec  int
+	cancel      context.CancelFunc
+	service     *MessageService
+	startedAt   time.Time
+	filePath    string
+	nameServer  string
+	targetTopic string
+	logPath     string
+
+	mu        sync.Mutex
+	pending   []model.MessageReplayItem // 尚未推送的逐条结果
+	pushed    int                       // 已推送的逐条结果数
+	processed int
+	succeeded int
+	failed    int
+	running   bool
+	cancelled bool
+	errMsg    string
+}
+
+// StartReplay 将导出文件中的消息回放到指定连接的 Topic
+// 回放在后台按 RatePerSecond 限速逐条发送，可替换标签、Keys 与属性；DryRun 时只构造消息不发送。
+// 进度通过 message:replay:progress 事件推送，逐条结果追加写入日志文件，并通过 message:replay:items 事件推送前 1000 条。
+func (s *MessageService) StartReplay(req model.MessageReplayRequest) (*model.MessageReplayProgress, error) {
+	filePath := strings.TrimSpace(req.FilePath)
+	targetTopic := strings.TrimSpace(req.TargetTopic)
+	if filePath == "" || targetTopic == "" {
+		return nil, fmt.Errorf("回放消息失败: 文件路径与目标 Topic 不能为空")
+	}
+
+	nameServer := strings.TrimSpace(req.NameServer)
+	if nameServer == "" {
+		nameServer = rocketmq.GetClientManager().GetDefaultConnection()
+	}
+	if nameServer == "" {
+		return nil, fmt.Errorf("回放消息失败: 未设置默认连接")
+	}
+	if !req.DryRun {
+		if err := checkConnectionProtectionConfirm(nameServer, "回放消息", req.Confirm, targetTopic); err != nil {
+			return nil, err
+		}
+	}
+
+	records, err := readExportFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("读取回放文件失败: %w", err)
+	}
+	if req.Limit > 0 && len(records) > req.Limit {
+		records = records[:req.Limit]
+	}
+
+	var producer rmq.Producer
+	if !req.DryRun {
+		if producer, err = rocketmq.GetClientManager().GetProducer(nameServer); err != nil {
+			return nil, fmt.Errorf("获取生产者失败（请先连接目标连接）: %w", err)
+		}
+	}
+
+	ratePerSecond := req.RatePerSecond
+	if ratePerSecond <= 0 {
+		ratePerSecond = defaultReplayRatePerSec
+	}
+	if ratePerSecond > maxReplayRatePerSec {
+		ratePerSecond = maxReplayRatePerSec
+	}
+
+	s.replaysMu.Lock()
+	defer s.replaysMu.Unlock()
+	if len(s.replays) >= maxConcurrentReplays {
+		return nil, fmt.Errorf("回放消息失败: 最多同时运行 %d 个回放，请等待或取消其他回放", maxConcurrentReplays)
+	}
+
+	logPath := filepath.Join(resolveAppDataDir(replayLogDirName),
+		fmt.Sprintf("%s-%s.jsonl", sanitizeFileName(targetTopic), time.Now().Format("20060102150405")))
+	if err := os.MkdirAll(filepath.Dir(logPath), 0o755); err != nil {
+		return nil, fmt.Errorf("创建回放日志失败: %w", err)
+	}
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("创建回放日志失败: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	replay := &messageReplay{
+		id:          fmt.Sprintf("replay-%d", s.getNextID()),
+		req:         req,
+		records:     records,
+		producer:    producer,
+		logFile:     logFile,
+		ratePerSec:  ratePerSecond,
+		cancel:      cancel,
+		service:     s,
+		startedAt:   time.Now(),
+		filePath:    filePath,
+		nameServer:  nameServer,
+		targetTopic: targetTopic,
+		logPath:     logPath,
+		pending:     make([]model.MessageReplayItem, 0),
+		running:     true,
+	}
+	s.replays[replay.id] = replay
+
+	go replay.run(ctx)
+	return replay.progress(), nil
+}
+
+// CancelReplay 取消正在运行的消息回放，已发送的消息不会撤回
+func (s *MessageService) CancelReplay(replayID string) error {
+	replayID = strings.TrimSpace(replayID)
+
+	s.replaysMu.Lock()
+	replay, ok := s.replays[replayID]
+	s.replaysMu.Unlock()
+	if !ok {
+		return fmt.Errorf("回放不存在或已结束: %s", replayID)
+	}
+
+	replay.mu.Lock()
+	replay.cancelled = true
+	replay.mu.Unlock()
+	replay.cancel()
+	return nil
+}
+
+// GetReplayProgress 获取正在运行的消息回放进度
+func (s *MessageService) GetReplayProgress(replayID string) (*model.MessageReplayProgress, error) {
+	replayID = strings.TrimSpace(replayID)
+
+	s.replaysMu.Lock()
+	replay, ok := s.replays[replayID]
+	s.replaysMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("回放不存在或已结束: %s", replayID)
+	}
+	return replay.progress(), nil
+}
+
+func (r *messageReplay) run(ctx context.Context) {
+	defer r.logFile.Close()
+
+	logEncoder := json.NewEncoder(r.logFile)
+	logEncoder.SetEscapeHTML(false)
+	ticker := time.NewTicker(time.Second / time.Duration(r.ratePerSec))
+	defer ticker.Stop()
+
+	sent := 0
+	lastReport := time.Now()
+	for index, record := range r.records {
+		if ctx.Err() != nil {
+			break
+		}
+
+		item := model.MessageReplayItem{
+			Line:        index + 1,
+			SourceMsgID: record.MessageID,
+			TargetTopic: r.targetTopic,
+		}
+
+		msg, err := buildReplayMessage(record, r.targetTopic, r.req)
+		if err == nil {
+			item.Tags = msg.GetTags()
+			item.Keys = msg.GetKeys()
+			item.Properties = replayUserProperties(msg)
+			item.BodySize = len(msg.Body)
+
+			if !r.req.DryRun {
+				if sent > 0 {
+					select {
+					case <-ctx.Done():
+					case <-ticker.C:
+					}
+					if ctx.Err() != nil {
+						break
+					}
+				}
+				sent++
+				item.NewMessageID, err = sendReplayMessage(ctx, r.producer, msg)
+			}
+		}
+
+		item.SentAt = formatNow()
+		if err != nil {
+			item.Error = err.Error()
+		} else {
+			item.Success = true
+		}
+
+		if err := logEncoder.Encode(item); err != nil {
+			r.recordError(fmt.Errorf("写入回放日志失败: %w", err))
+			break
+		}
+		r.record(item)
+
+		if time.Since(lastReport) >= replayReportInterval {
+			lastReport = time.Now()
+			r.flush()
+		}
+	}
+
+	r.service.replaysMu.Lock()
+	if r.service.replays[r.id] == r {
+		delete(r.service.replays, r.id)
+	}
+	r.service.replaysMu.Unlock()
+
+	r.mu.Lock()
+	r.running = false
+	r.mu.Unlock()
+	r.cancel()
+	r.flush()
+}
+
+// record 累计一条回放结果
+func (r *messageReplay) record(item model.MessageReplayItem) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.processed++
+	if item.Success {
+		r.succeeded++
+	} else {
+		r.failed++
+	}
+	if r.pushed+len(r.pending) < maxReplayPushedItems {
+		r.pending = append(r.pending, item)
+	}
+}
+
+// recordError 记录中止回放的错误
+func (r *messageReplay) recordError(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.errMsg = err.Error()
+	log.Printf("[MessageService] 消息回放 %s(%s) 中止: %v", r.id, r.targetTopic, err)
+}
+
+// flush 推送尚未推送的逐条结果与当前进度
+func (r *messageReplay) flush() {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make([]model.MessageReplayItem, 0)
+	r.pushed += len(pending)
+	r.mu.Unlock()
+
+	if len(pending) > 0 {
+		emitEvent(EventReplayItems, model.MessageReplayItemsEvent{
+			ReplayID: r.id,
+			Items:    pending,
+		})
+	}
+	emitEvent(EventReplayProgress, *r.progress())
+}
+
+func (r *messageReplay) progress() *model.MessageReplayProgress {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &model.MessageReplayProgress{
+		ReplayID:      r.id,
+		FilePath:      r.filePath,
+		NameServer:    r.nameServer,
+		TargetTopic:   r.targetTopic,
+		DryRun:        r.req.DryRun,
+		Total:         len(r.records),
+		Processed:     r.processed,
+		Succeeded:     r.succeeded,
+		Failed:        r.failed,
+		Running:       r.running,
+		Cancelled:     r.cancelled,
+		Error:         r.errMsg,
+		LogPath:       r.logPath,
+		StartedAt:     r.startedAt.Format("2006-01-02 15:04:05"),
+		ElapsedMillis: time.Since(r.startedAt).Milliseconds(),
+	}
+}
+
+// buildReplayMessage 根据导出记录构造回放消息：保留用户属性，去掉系统属性，再应用标签、Keys 与属性改写
+func buildReplayMessage(record model.ExportedMessage, targetTopic string, req model.MessageReplayRequest) (*primitive.Message, error) {
+	// 压缩标记不会随消息回放，发送未解压的数据会让消费者收到无法识别的消息体
+	if record.Compressed {
+		return nil, fmt.Errorf("消息体仍是未能解压的 %s 压缩数据，不能回放", record.Compression)
+	}
+
+	body := []byte(record.Body)
+	if record.BodyEncoding == model.BodyEncodingBase64 {
+		decoded, err := base64.StdEncoding.DecodeString(record.Body)
+		if err != nil {
+			return nil, fmt.Errorf("消息体 base64 解码失败: %w", err)
+		}
+		body = decoded
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("消息体为空")
+	}
+
+	msg := primitive.NewMessage(targetTopic, body)
+	for key, value := range record.Properties {
+		if _, dropped := redeliverDroppedProperties[key]; dropped {
+			continue
+		}
+		switch key {
+		case propertyTags, propertyKeys, propertyRetryTopic, propertyOriginMessageID:
+			continue
+		}
+		msg.WithProperty(key, value)
+	}
+
+	removed := make(map[string]struct{}, len(req.RemoveProperties))
+	for _, key := range req.RemoveProperties {
+		removed[strings.TrimSpace(key)] = struct{}{}
+	}
+	for key, value := range req.Properties {
+		if key = strings.TrimSpace(key); key != "" {
+			msg.WithProperty(key, value)
+		}
+	}
+
+	tags := record.Tags
+	if rewritten := strings.TrimSpace(req.Tags); rewritten != "" {
+		tags = rewritten
+	}
+	if _, ok := removed[propertyTags]; !ok && tags != "" {
+		msg.WithTag(tags)
+	}
+
+	keys := strings.Fields(record.Keys)
+	if len(req.Keys) > 0 {
+		keys = make([]string, 0, len(req.Keys))
+		for _, key := range req.Keys {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+	if _, ok := removed[propertyKeys]; !ok && len(keys) > 0 {
+		msg.WithKeys(keys)
+	}
+
+	for key := range removed {
+		msg.RemoveProperty(key)
+	}
+	return msg, nil
+}
+
+// replayUserProperties 获取回放消息中除标签、Keys 外的属性，用于结果展示
+func replayUserProperties(msg *primitive.Message) map[string]string {
+	properties := make(map[string]string)
+	for key, value := range msg.GetProperties() {
+		if key == propertyTags || key == propertyKeys {
+			continue
+		}
+		properties[key] = value
+	}
+	return properties
+}
+
+// sendReplayMessage 同步发送一条回放消息，返回新消息ID
+func sendReplayMessage(parent context.Context, producer rmq.Producer, msg *primitive.Message) (string, error) {
+	ctx, cancel := context.WithTimeout(parent, 15*time.Second)
+	defer cancel()
+
+	sendResult, err := producer.SendSync(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+	if sendResult.Status != primitive.SendOK {
+		return sendResult.MsgID, fmt.Errorf("发送状态: %s", sendStatusDesc(sendResult.Status))
+	}
+	return sendResult.MsgID, nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const (
+	defaultRetryMaxResults    = 200
+	maxRetryScanMessages      = 50000
+	defaultRetryBucketMinutes = 10
+	// 重试消息再次失败时 Broker 使用的延迟级别为 3 + 重试次数
+	retryDelayLevelBase = 3
+	// 重试次数达到该值的原始消息视为疑似毒消息
+	poisonReconsumeThreshold = 3
+)
+
+// defaultDelayLevels Broker 默认 messageDelayLevel 配置
+var defaultDelayLevels = strings.Fields("1s 5s 10s 30s 1m 2m 3m 4m 5m 6m 7m 8m 9m 10m 20m 30m 1h 2h")
+
+// QueryRetryMessages 浏览消费者组重试 Topic 中的消息并统计重试率
+// 统计基于时间范围内扫描到的全部重试消息，Messages 仅返回前 MaxResults 条。
+func (s *MessageService) QueryRetryMessages(params model.RetryQueryParams) (*model.RetryQueryResult, error) {
+	group := strings.TrimSpace(params.Group)
+	if group == "" {
+		return nil, fmt.Errorf("查询重试消息失败: 消费者组名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	maxResults := params.MaxResults
+	if maxResults <= 0 {
+		maxResults = defaultRetryMaxResults
+	}
+	bucketMinutes := params.BucketMinutes
+	if bucketMinutes <= 0 {
+		bucketMinutes = defaultRetryBucketMinutes
+	}
+	bucketMillis := int64(bucketMinutes) * 60 * 1000
+
+	maxRetryTimes := defaultGroupRetryMaxTimes
+	if brokerConfigs, err := fetchGroupBrokerConfigs(client, group); err == nil {
+		for _, item := range brokerConfigs {
+			if item.config != nil && item.config.RetryMaxTimes > 0 {
+				maxRetryTimes = item.config.RetryMaxTimes
+				break
+			}
+		}
+	}
+
+	result := &model.RetryQueryResult{
+		Group:            group,
+		Topic:            retryTopicPrefix + group,
+		MaxRetryTimes:    maxRetryTimes,
+		Messages:         make([]model.RetryMessageItem, 0),
+		Buckets:          make([]model.RetryRateBucket, 0),
+		PoisonCandidates: make([]model.PoisonMessageCandidate, 0),
+	}
+
+	type bucketStat struct {
+		count      int
+		maxRetries int
+		origins    map[string]struct{}
+	}
+	type originStat struct {
+		candidate model.PoisonMessageCandidate
+		firstTs   int64
+		lastTs    int64
+	}
+	buckets := make(map[int64]*bucketStat)
+	origins := make(map[string]*originStat)
+
+	opts := topicScanOptions{
+		topic:     result.Topic,
+		startTime: params.StartTime,
+		endTime:   params.EndTime,
+		key:       strings.TrimSpace(params.Key),
+		scanLimit: maxRetryScanMessages,
+	}
+	scanned, err := scanTopicMessages(context.Background(), client, opts, func(msg *admin.MessageExt) bool {
+		result.Matched++
+		reconsumeTimes := int(msg.ReconsumeTimes)
+
+		originID := msg.Properties[propertyOriginMessageID]
+		if originID == "" {
+			originID = msg.MsgId
+		}
+
+		bucketStart := msg.StoreTimestamp - msg.StoreTimestamp%bucketMillis
+		bucket, ok := buckets[bucketStart]
+		if !ok {
+			bucket = &bucketStat{origins: make(map[string]struct{})}
+			buckets[bucketStart] = bucket
+		}
+		bucket.count++
+		bucket.origins[originID] = struct{}{}
+		if reconsumeTimes > bucket.maxRetries {
+			bucket.maxRetries = reconsumeTimes
+		}
+
+		origin, ok := origins[originID]
+		if !ok {
+			origin = &originStat{
+				candidate: model.PoisonMessageCandidate{
+					OriginMsgID: originID,
+					OriginTopic: msg.Properties[propertyRetryTopic],
+					Keys:        msg.Properties[propertyKeys],
+				},
+				firstTs: msg.StoreTimestamp,
+				lastTs:  msg.StoreTimestamp,
+			}
+			origins[originID] = origin
+		}
+		origin.candidate.Occurrences++
+		if reconsumeTimes > origin.candidate.MaxReconsumeTimes {
+			origin.candidate.MaxReconsumeTimes = reconsumeTimes
+		}
+		if msg.StoreTimestamp < origin.firstTs {
+			origin.firstTs = msg.StoreTimestamp
+		}
+		if msg.StoreTimestamp > origin.lastTs {
+			origin.lastTs = msg.StoreTimestamp
+		}
+
+		if len(result.Messages) < maxResults {
+			result.Messages = append(result.Messages, toRetryMessageItem(s.toMessageItem(msg), reconsumeTimes, maxRetryTimes))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Scanned = scanned
+	result.Truncated = scanned >= maxRetryScanMessages
+
+	bucketStarts := make([]int64, 0, len(buckets))
+	for start := range buckets {
+		bucketStarts = append(bucketStarts, start)
+	}
+	sort.Slice(bucketStarts, func(i, j int) bool { return bucketStarts[i] < bucketStarts[j] })
+	for _, start := range bucketStarts {
+		bucket := buckets[start]
+		result.Buckets = append(result.Buckets, model.RetryRateBucket{
+			StartTime:  start,
+			Count:      bucket.count,
+			Distinct:   len(bucket.origins),
+			PerMinute:  float64(bucket.count) / float64(bucketMinutes),
+			MaxRetries: bucket.maxRetries,
+		})
+	}
+
+	for _, origin := range origins {
+		if origin.candidate.MaxReconsumeTimes < poisonReconsumeThreshold {
+			continue
+		}
+		origin.candidate.FirstSeen = formatTimestamp(origin.firstTs)
+		origin.candidate.LastSeen = formatTimestamp(origin.lastTs)
+		result.PoisonCandidates = append(result.PoisonCandidates, origin.candidate)
+	}
+	sort.Slice(result.PoisonCandidates, func(i, j int) bool {
+		a, b := result.PoisonCandidates[i], result.PoisonCandidates[j]
+		if a.MaxReconsumeTimes != b.MaxReconsumeTimes {
+			return a.MaxReconsumeTimes > b.MaxReconsumeTimes
+		}
+		if a.Occurrences != b.Occurrences {
+			return a.Occurrences > b.Occurrences
+		}
+		return a.OriginMsgID < b.OriginMsgID
+	})
+
+	return result, nil
+}
+
+// toRetryMessageItem 根据重试次数计算下一次投递的延迟级别
+func toRetryMessageItem(item *model.MessageItem, reconsumeTimes int, maxRetryTimes int) model.RetryMessageItem {
+	level := retryDelayLevelBase + reconsumeTimes
+	if level > len(defaultDelayLevels) {
+		level = len(defaultDelayLevels)
+	}
+
+	return model.RetryMessageItem{
+		Message:        item,
+		NextDelayLevel: level,
+		NextRetryDelay: defaultDelayLevels[level-1],
+		WillDeadLetter: reconsumeTimes >= maxRetryTimes,
+	}
+}
@@ -0,0 +1,409 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const (
+	defaultSearchWindow      = time.Hour
+	defaultSearchMaxMatches  = 200
+	maxSearchMaxMatches      = 2000
+	defaultSearchScanLimit   = 100000
+	maxSearchScanLimit       = 1000000
+	defaultSearchConcurrency = 4
+	maxSearchConcurrency     = 16
+	maxConcurrentSearches    = 3
+	searchReportInterval     = 500 * time.Millisecond
+)
+
+// bodyMatcher 判断消息体是否命中
+type bodyMatcher func(content []byte) bool
+
+// bodySearch 一次后台运行的消息体搜索
+type bodySearch struct {
+	id         string
+	topic      string
+	startTime  int64
+	endTime    int64
+	matcher    bodyMatcher
+	maxMatches int64
+	scanLimit  int64
+	cancel     context.CancelFunc
+	service    *MessageService
+	startedAt  time.Time
+
+	queuesTotal int
+	queuesDone  atomic.Int64
+	scanned     atomic.Int64
+	matched     atomic.Int64
+
+	mu        sync.Mutex
+	pending   []*model.MessageItem // 尚未推送的命中消息
+	running   bool
+	truncated bool
+	cancelled bool
+	errMsg    string
+}
+
+// StartBodySearch 在时间窗口内并行扫描 Topic 各队列，按子串、正则或 JSONPath 搜索消息体
+// 搜索在后台运行：命中的消息通过 message:search:match 事件分批推送，进度通过 message:search:progress 事件推送，
+// 达到扫描上限或命中上限时提前结束。消息体按 Topic 记住的 Protobuf/Avro 解码器解码后再匹配。
+func (s *MessageService) StartBodySearch(req model.BodySearchRequest) (*model.BodySearchProgress, error) {
+	topic := strings.TrimSpace(req.Topic)
+	if topic == "" {
+		return nil, fmt.Errorf("搜索消息体失败: Topic 不能为空")
+	}
+
+	matcher, err := newBodyMatcher(req)
+	if err != nil {
+		return nil, fmt.Errorf("搜索消息体失败: %w", err)
+	}
+
+	endTime := req.EndTime
+	if endTime <= 0 {
+		endTime = time.Now().UnixMilli()
+	}
+	startTime := req.StartTime
+	if startTime <= 0 {
+		startTime = endTime - defaultSearchWindow.Milliseconds()
+	}
+	if startTime > endTime {
+		return nil, fmt.Errorf("搜索消息体失败: 开始时间不能晚于结束时间")
+	}
+
+	maxMatches := req.MaxMatches
+	if maxMatches <= 0 {
+		maxMatches = defaultSearchMaxMatches
+	}
+	if maxMatches > maxSearchMaxMatches {
+		maxMatches = maxSearchMaxMatches
+	}
+	scanLimit := req.ScanLimit
+	if scanLimit <= 0 {
+		scanLimit = defaultSearchScanLimit
+	}
+	if scanLimit > maxSearchScanLimit {
+		scanLimit = maxSearchScanLimit
+	}
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultSearchConcurrency
+	}
+	if concurrency > maxSearchConcurrency {
+		concurrency = maxSearchConcurrency
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	queues, err := fetchTopicQueues(client, topic)
+	if err != nil {
+		return nil, fmt.Errorf("获取 Topic %s 路由失败: %w", topic, err)
+	}
+	if len(queues) == 0 {
+		return nil, fmt.Errorf("搜索消息体失败: Topic %s 没有可读队列", topic)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	search := &bodySearch{
+		id:          fmt.Sprintf("search-%d", s.getNextID()),
+		topic:       topic,
+		startTime:   startTime,
+		endTime:     endTime,
+		matcher:     matcher,
+		maxMatches:  int64(maxMatches),
+		scanLimit:   int64(scanLimit),
+		cancel:      cancel,
+		service:     s,
+		startedAt:   time.Now(),
+		queuesTotal: len(queues),
+		pending:     make([]*model.MessageItem, 0),
+		running:     true,
+	}
+
+	s.searchesMu.Lock()
+	if len(s.searches) >= maxConcurrentSearches {
+		s.searchesMu.Unlock()
+		cancel()
+		return nil, fmt.Errorf("搜索消息体失败: 最多同时运行 %d 个搜索，请等待或取消其他搜索", maxConcurrentSearches)
+	}
+	s.searches[search.id] = search
+	s.searchesMu.Unlock()
+
+	go search.run(ctx, client, queues, concurrency)
+	return search.progress(), nil
+}
+
+// CancelBodySearch 取消正在运行的消息体搜索
+func (s *MessageService) CancelBodySearch(searchID string) error {
+	searchID = strings.TrimSpace(searchID)
+
+	s.searchesMu.Lock()
+	search, ok := s.searches[searchID]
+	s.searchesMu.Unlock()
+	if !ok {
+		return fmt.Errorf("搜索不存在或已结束: %s", searchID)
+	}
+
+	search.mu.Lock()
+	search.cancelled = true
+	search.mu.Unlock()
+	search.cancel()
+	return nil
+}
+
+// GetBodySearchProgress 获取正在运行的消息体搜索进度
+func (s *MessageService) GetBodySearchProgress(searchID string) (*model.BodySearchProgress, error) {
+	searchID = strings.TrimSpace(searchID)
+
+	s.searchesMu.Lock()
+	search, ok := s.searches[searchID]
+	s.searchesMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("搜索不存在或已结束: %s", searchID)
+	}
+	return search.progress(), nil
+}
+
+// newBodyMatcher 根据搜索方式构造消息体匹配函数
+func newBodyMatcher(req model.BodySearchRequest) (bodyMatcher, error) {
+	pattern := req.Pattern
+	if strings.TrimSpace(pattern) == "" {
+		return nil, fmt.Errorf("搜索内容不能为空")
+	}
+
+	switch req.Mode {
+	case model.SearchSubstring, "":
+		if req.CaseSensitive {
+			needle := []byte(pattern)
+			return func(content []byte) bool {
+				return bytes.Contains(content, needle)
+			}, nil
+		}
+		needle := bytes.ToLower([]byte(pattern))
+		return func(content []byte) bool {
+			return bytes.Contains(bytes.ToLower(content), needle)
+		}, nil
+	case model.SearchRegex:
+		if !req.CaseSensitive {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("正则表达式无效: %w", err)
+		}
+		return re.Match, nil
+	case model.SearchJSONPath:
+		steps, err := parseJSONPath(pattern)
+		if err != nil {
+			return nil, err
+		}
+		expected := req.JSONValue
+		return func(content []byte) bool {
+			document, ok := decodeJSONDocument(content)
+			if !ok {
+				return false
+			}
+			nodes := evalJSONPath(document, steps)
+			if expected == "" {
+				return len(nodes) > 0
+			}
+			for _, node := range nodes {
+				text := jsonValueText(node)
+				if text == expected || (!req.CaseSensitive && strings.EqualFold(text, expected)) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	default:
+		return nil, fmt.Errorf("不支持的搜索方式: %s", req.Mode)
+	}
+}
+
+// searchableBody 获取用于搜索的消息体：Topic 配置了 Protobuf/Avro 解码器时使用解码后的 JSON，否则使用解压后的原文
+func (s *MessageService) searchableBody(msg *admin.MessageExt) []byte {
+	if config, ok := s.rememberedDecoder(decoderTopicOf(msg)); ok &&
+		(config.Decoder == model.DecoderProtobuf || config.Decoder == model.DecoderAvro) {
+		if decoded := s.decodeMessageBody(msg, config); decoded.Error == "" {
+			return []byte(decoded.Content)
+		}
+	}
+	body, _, _ := decompressBody(msg.Body, msg.SysFlag)
+	return body
+}
+
+func (b *bodySearch) run(ctx context.Context, client *admin.Client, queues []messageQueueRef, concurrency int) {
+	queueCh := make(chan messageQueueRef)
+	var workers sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for queue := range queueCh {
+				b.searchQueue(ctx, client, queue)
+				b.queuesDone.Add(1)
+			}
+		}()
+	}
+
+	stopReporter := make(chan struct{})
+	var reporter sync.WaitGroup
+	reporter.Add(1)
+	go func() {
+		defer reporter.Done()
+		ticker := time.NewTicker(searchReportInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopReporter:
+				return
+			case <-ticker.C:
+				b.flush()
+			}
+		}
+	}()
+
+	for _, queue := range queues {
+		if ctx.Err() != nil {
+			break
+		}
+		queueCh <- queue
+	}
+	close(queueCh)
+	workers.Wait()
+	// 等待定时推送退出，确保最后一次推送的是最终进度
+	close(stopReporter)
+	reporter.Wait()
+
+	b.service.searchesMu.Lock()
+	if b.service.searches[b.id] == b {
+		delete(b.service.searches, b.id)
+	}
+	b.service.searchesMu.Unlock()
+
+	b.mu.Lock()
+	b.running = false
+	b.mu.Unlock()
+	b.cancel()
+	b.flush()
+}
+
+// searchQueue 扫描单个队列时间窗口内的消息
+func (b *bodySearch) searchQueue(ctx context.Context, client *admin.Client, queue messageQueueRef) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	start, end, err := resolveQueueTimeRange(client, queue, b.startTime, b.endTime)
+	if err != nil {
+		b.recordError(fmt.Errorf("获取队列 %s-%d 位点失败: %w", queue.mq.BrokerName, queue.mq.QueueId, err))
+		return
+	}
+
+	_, err = scanQueueRange(ctx, client, queue, start, end, func(msg *admin.MessageExt) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		if b.scanned.Add(1) > b.scanLimit {
+			b.scanned.Add(-1)
+			b.stopTruncated()
+			return false
+		}
+		if msg.StoreTimestamp < b.startTime || msg.StoreTimestamp > b.endTime {
+			return true
+		}
+		if !b.matcher(b.service.searchableBody(msg)) {
+			return true
+		}
+
+		matched := b.matched.Add(1)
+		if matched > b.maxMatches {
+			b.matched.Add(-1)
+			b.stopTruncated()
+			return false
+		}
+		item := b.service.toMessageItem(msg)
+		b.mu.Lock()
+		b.pending = append(b.pending, item)
+		b.mu.Unlock()
+		if matched == b.maxMatches {
+			b.stopTruncated()
+			return false
+		}
+		return true
+	})
+	if err != nil && ctx.Err() == nil {
+		b.recordError(err)
+	}
+}
+
+// stopTruncated 达到扫描上限或命中上限时结束搜索
+func (b *bodySearch) stopTruncated() {
+	b.mu.Lock()
+	b.truncated = true
+	b.mu.Unlock()
+	b.cancel()
+}
+
+// recordError 记录第一个错误，其余队列继续搜索
+func (b *bodySearch) recordError(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.errMsg == "" {
+		b.errMsg = err.Error()
+		log.Printf("[MessageService] 消息体搜索 %s(%s) 出错: %v", b.id, b.topic, err)
+	}
+}
+
+// flush 推送尚未推送的命中消息与当前进度
+func (b *bodySearch) flush() {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = make([]*model.MessageItem, 0)
+	b.mu.Unlock()
+
+	if len(pending) > 0 {
+		emitEvent(EventSearchMatch, model.BodySearchMatchEvent{
+			SearchID: b.id,
+			Messages: pending,
+		})
+	}
+	emitEvent(EventSearchProgress, *b.progress())
+}
+
+func (b *bodySearch) progress() *model.BodySearchProgress {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return &model.BodySearchProgress{
+		SearchID:      b.id,
+		Topic:         b.topic,
+		Scanned:       b.scanned.Load(),
+		Matched:       b.matched.Load(),
+		QueuesDone:    int(b.queuesDone.Load()),
+		QueuesTotal:   b.queuesTotal,
+		Running:       b.running,
+		Truncated:     b.truncated,
+		Cancelled:     b.cancelled,
+		Error:         b.errMsg,
+		StartedAt:     b.startedAt.Format("2006-01-02 15:04:05"),
+		ElapsedMillis: time.Since(b.startedAt).Milliseconds(),
+	}
+}
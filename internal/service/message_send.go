@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+)
+
+const (
+	// propertyTimerDeliverMs RocketMQ 5.x 定时消息投递时间属性
+	propertyTimerDeliverMs = "TIMER_DELIVER_MS"
+	maxDelayLevel          = 18
+)
+
+// SendMessage 使用默认连接的生产者发送一条消息
+// 生产者使用连接配置中的 ACL 凭证；指定 BrokerName 时发送到该 Broker 的 QueueID 队列，
+// 设置 ShardingKey 时按键哈希选择队列以保证同键消息有序。
+func (s *MessageService) SendMessage(req model.SendMessageRequest) (*model.SendMessageResult, error) {
+	msg, err := buildSendMessage(req)
+	if err != nil {
+		return nil, fmt.Errorf("发送消息失败: %w", err)
+	}
+
+	producer, err := rocketmq.GetClientManager().GetDefaultProducer()
+	if err != nil {
+		return nil, fmt.Errorf("获取生产者失败: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	sendResult, err := producer.SendSync(ctx, msg)
+	if err != nil {
+		if msg.Queue != nil {
+			return nil, fmt.Errorf("发送消息到队列 %s-%d 失败，请确认该队列是 Topic 的可写队列: %w", msg.Queue.BrokerName, msg.Queue.QueueId, err)
+		}
+		return nil, fmt.Errorf("发送消息失败: %w", err)
+	}
+
+	result := &model.SendMessageResult{
+		Status:      sendStatusDesc(sendResult.Status),
+		MessageID:   sendResult.MsgID,
+		OffsetMsgID: sendResult.OffsetMsgID,
+		Topic:       msg.Topic,
+		QueueOffset: sendResult.QueueOffset,
+	}
+	if sendResult.MessageQueue != nil {
+		result.BrokerName = sendResult.MessageQueue.BrokerName
+		result.QueueID = sendResult.MessageQueue.QueueId
+	}
+
+	return result, nil
+}
+
+// buildSendMessage 校验发送请求并构造消息
+func buildSendMessage(req model.SendMessageRequest) (*primitive.Message, error) {
+	topic := strings.TrimSpace(req.Topic)
+	if topic == "" {
+		return nil, fmt.Errorf("Topic 不能为空")
+	}
+	if req.DelayLevel < 0 || req.DelayLevel > maxDelayLevel {
+		return nil, fmt.Errorf("延迟级别必须在 0-%d 之间，0 表示不延迟", maxDelayLevel)
+	}
+	if req.DelayLevel > 0 && req.DeliverTime > 0 {
+		return nil, fmt.Errorf("延迟级别与定时投递时间不能同时设置")
+	}
+	if req.DeliverTime > 0 && req.DeliverTime <= time.Now().UnixMilli() {
+		return nil, fmt.Errorf("定时投递时间必须晚于当前时间")
+	}
+
+	brokerName := strings.TrimSpace(req.BrokerName)
+	shardingKey := strings.TrimSpace(req.ShardingKey)
+	if brokerName != "" && shardingKey != "" {
+		return nil, fmt.Errorf("指定队列与分区顺序键不能同时设置")
+	}
+
+	msg := primitive.NewMessage(topic, []byte(req.Body))
+	for key, value := range req.Properties {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		msg.WithProperty(key, value)
+	}
+
+	if tags := strings.TrimSpace(req.Tags); tags != "" {
+		msg.WithTag(tags)
+	}
+
+	keys := make([]string, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > 0 {
+		msg.WithKeys(keys)
+	}
+
+	if req.DelayLevel > 0 {
+		msg.WithDelayTimeLevel(req.DelayLevel)
+	}
+	if req.DeliverTime > 0 {
+		msg.WithProperty(propertyTimerDeliverMs, strconv.FormatInt(req.DeliverTime, 10))
+	}
+	if shardingKey != "" {
+		msg.WithShardingKey(shardingKey)
+	}
+	if brokerName != "" {
+		msg.Queue = &primitive.MessageQueue{
+			Topic:      topic,
+			BrokerName: brokerName,
+			QueueId:    req.QueueID,
+		}
+	}
+
+	return msg, nil
+}
+
+// sendStatusDesc 发送状态描述
+func sendStatusDesc(status primitive.SendStatus) string {
+	switch status {
+	case primitive.SendOK:
+		return "SEND_OK"
+	case primitive.SendFlushDiskTimeout:
+		return "FLUSH_DISK_TIMEOUT"
+	case primitive.SendFlushSlaveTimeout:
+		return "FLUSH_SLAVE_TIMEOUT"
+	case primitive.SendSlaveNotAvailable:
+		return "SLAVE_NOT_AVAILABLE"
+	default:
+		return "UNKNOWN_ERROR"
+	}
+}
@@ -3,22 +3,53 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"rocket-leaf/internal/model"
 	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// RocketMQ 消息系统属性
+const (
+	propertyTags            = "TAGS"
+	propertyKeys            = "KEYS"
+	propertyRetryTopic      = "RETRY_TOPIC"
+	propertyOriginMessageID = "ORIGIN_MESSAGE_ID"
+	propertyUniqKey         = "UNIQ_KEY"
 )
 
 // MessageService 消息查询服务
 type MessageService struct {
-	nextID int64
+	nextID        int64
+	redeliveryMu  sync.Mutex // 保护死信重投记录读写
+	redeliveryDir string     // 死信重投记录存储目录
+	decoders      *topicDecoderStore
+	timeQueries   *timeQueryCache
+	tailsMu       sync.Mutex            // 保护实时跟踪列表
+	tails         map[string]*topicTail // key: 跟踪ID
+
+	searchesMu sync.Mutex             // 保护消息体搜索列表
+	searches   map[string]*bodySearch // key: 搜索ID
+
+	replaysMu sync.Mutex                // 保护消息回放列表
+	replays   map[string]*messageReplay // key: 回放ID
 }
 
 // NewMessageService 创建消息查询服务
 func NewMessageService() *MessageService {
 	return &MessageService{
-		nextID: 1,
+		nextID:        1,
+		redeliveryDir: resolveAppDataDir(redeliveryRecordDirName),
+		decoders:      newTopicDecoderStore(resolveAppDataDir(topicDecoderFileName)),
+		timeQueries:   newTimeQueryCache(),
+		tails:         make(map[string]*topicTail),
+		searches:      make(map[string]*bodySearch),
+		replays:       make(map[string]*messageReplay),
 	}
 }
 
@@ -47,85 +78,49 @@ func (s *MessageService) QueryMessages(topic string, key string, maxResults int)
 
 	result := make([]*model.MessageItem, 0, len(msgs))
 	for _, msg := range msgs {
-		// MessageExt 使用 Properties map 获取 Tags 和 Keys
-		tags := ""
-		keys := ""
-		if msg.Properties != nil {
-			if t, ok := msg.Properties["TAGS"]; ok {
-				tags = t
-			}
-			if k, ok := msg.Properties["KEYS"]; ok {
-				keys = k
-			}
-		}
-
-		item := &model.MessageItem{
-			ID:             s.getNextID(),
-			Topic:          msg.Topic,
-			MessageID:      msg.MsgId,
-			Tags:           tags,
-			Keys:           keys,
-			QueueID:        msg.QueueId,
-			QueueOffset:    msg.QueueOffset,
-			StoreHost:      msg.StoreHost,
-			BornHost:       msg.BornHost,
-			StoreTime:      time.Unix(msg.StoreTimestamp/1000, 0).Format("2006-01-02 15:04:05"),
-			StoreTimestamp: msg.StoreTimestamp,
-			Body:           string(msg.Body),
-			Properties:     msg.Properties,
-			Status:         model.MsgNormal,
-		}
-
-		result = append(result, item)
+		result = append(result, s.toMessageItem(msg))
 	}
 
 	return result, nil
 }
 
 // QueryMessageByID 按消息 ID 查询消息
+// topic 为空时只支持偏移消息ID：从 ID 中解析存储节点与 CommitLog 偏移后直接查询；
+// 指定 topic 但查询失败时，若为偏移消息ID也会回退到直接查询。
 func (s *MessageService) QueryMessageByID(topic string, msgID string) (*model.MessageItem, error) {
+	topic = strings.TrimSpace(topic)
+	msgID = strings.TrimSpace(msgID)
+	if msgID == "" {
+		return nil, fmt.Errorf("查询消息失败: 消息ID不能为空")
+	}
+
 	client, err := rocketmq.GetClientManager().GetDefaultClient()
 	if err != nil {
 		return nil, fmt.Errorf("获取客户端失败: %w", err)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	msg, err := client.ViewMessage(ctx, topic, msgID)
-	if err != nil {
-		return nil, fmt.Errorf("查询消息失败: %w", err)
-	}
+	info := decodeMessageID(msgID, time.Now())
+	if topic != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
 
-	tags := ""
-	keys := ""
-	if msg.Properties != nil {
-		if t, ok := msg.Properties["TAGS"]; ok {
-			tags = t
+		msg, err := client.ViewMessage(ctx, topic, msgID)
+		if err == nil {
+			return s.toMessageItem(msg), nil
 		}
-		if k, ok := msg.Properties["KEYS"]; ok {
-			keys = k
+		if info.Type != model.MessageIDOffset {
+			return nil, fmt.Errorf("查询消息失败: %w", err)
 		}
+	} else if info.Type != model.MessageIDOffset {
+		return nil, fmt.Errorf("查询消息失败: %s 不是偏移消息ID，需要指定 Topic", msgID)
 	}
 
-	item := &model.MessageItem{
-		ID:             s.getNextID(),
-		Topic:          msg.Topic,
-		MessageID:      msg.MsgId,
-		Tags:           tags,
-		Keys:           keys,
-		QueueID:        msg.QueueId,
-		QueueOffset:    msg.QueueOffset,
-		StoreHost:      msg.StoreHost,
-		BornHost:       msg.BornHost,
-		StoreTime:      time.Unix(msg.StoreTimestamp/1000, 0).Format("2006-01-02 15:04:05"),
-		StoreTimestamp: msg.StoreTimestamp,
-		Body:           string(msg.Body),
-		Properties:     msg.Properties,
-		Status:         model.MsgNormal,
+	msg, err := viewMessageByOffsetID(client, info)
+	if err != nil {
+		return nil, fmt.Errorf("按偏移消息ID查询消息失败（%s@%d）: %w", info.StoreHost, info.CommitLogOffset, err)
 	}
 
-	return item, nil
+	return s.toMessageItem(msg), nil
 }
 
 // GetMessageDetail 获取消息详情
@@ -133,13 +128,6 @@ func (s *MessageService) GetMessageDetail(topic string, msgID string) (*model.Me
 	return s.QueryMessageByID(topic, msgID)
 }
 
-// GetMessageTrack 获取消息轨迹
-// 注意：rocketmq-admin-go 库当前版本暂不支持 MessageTrackDetail
-func (s *MessageService) GetMessageTrack(topic string, msgID string) ([]map[string]interface{}, error) {
-	// TODO: 待 rocketmq-admin-go 库支持后实现
-	return []map[string]interface{}{}, nil
-}
-
 // ResendMessage 重投消息
 func (s *MessageService) ResendMessage(consumerGroup string, clientID string, topic string, msgID string) (string, error) {
 	client, err := rocketmq.GetClientManager().GetDefaultClient()
@@ -157,3 +145,45 @@ func (s *MessageService) ResendMessage(consumerGroup string, clientID string, to
 
 	return fmt.Sprintf("消息重投结果: %v", result), nil
 }
+
+// toMessageItem 将 MessageExt 转换为前端展示的消息信息
+func (s *MessageService) toMessageItem(msg *admin.MessageExt) *model.MessageItem {
+	// MessageExt 使用 Properties map 获取 Tags 和 Keys
+	tags := ""
+	keys := ""
+	if msg.Properties != nil {
+		tags = msg.Properties[propertyTags]
+		keys = msg.Properties[propertyKeys]
+	}
+
+	item := &model.MessageItem{
+		ID:             s.getNextID(),
+		Topic:          msg.Topic,
+		MessageID:      msg.MsgId,
+		Tags:           tags,
+		Keys:           keys,
+		QueueID:        msg.QueueId,
+		QueueOffset:    msg.QueueOffset,
+		StoreHost:      msg.StoreHost,
+		BornHost:       msg.BornHost,
+		StoreTime:      time.Unix(msg.StoreTimestamp/1000, 0).Format("2006-01-02 15:04:05"),
+		StoreTimestamp: msg.StoreTimestamp,
+		RetryTimes:     int(msg.ReconsumeTimes),
+		Properties:     msg.Properties,
+		Status:         model.MsgNormal,
+	}
+
+	switch {
+	case strings.HasPrefix(msg.Topic, retryTopicPrefix):
+		item.Status = model.MsgRetry
+	case strings.HasPrefix(msg.Topic, dlqTopicPrefix):
+		item.Status = model.MsgDLQ
+	}
+	if item.Status != model.MsgNormal && msg.Properties != nil {
+		item.OriginTopic = msg.Properties[propertyRetryTopic]
+		item.OriginMsgID = msg.Properties[propertyOriginMessageID]
+	}
+	s.applyMessageBody(item, msg)
+
+	return item
+}
@@ -0,0 +1,390 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const (
+	defaultTailRatePerSecond   = 100
+	maxTailRatePerSecond       = 1000
+	defaultTailIntervalMillis  = 1000
+	minTailIntervalMillis      = 200
+	defaultTailDurationSeconds = 1800
+	maxTailDurationSeconds     = 4 * 3600
+	maxConcurrentTails         = 5
+	tailRouteRefreshInterval   = 30 * time.Second
+)
+
+// topicTail 单个 Topic 的实时跟踪
+// 通过 Admin 客户端直接按队列位点拉取，不注册消费者组，也不提交任何消费位点。
+type topicTail struct {
+	id       string
+	topic    string
+	filter   *messageFilter
+	maxRate  int
+	interval time.Duration
+	duration time.Duration
+	cancel   context.CancelFunc
+	service  *MessageService
+
+	// 以下字段只在跟踪协程内访问
+	queues   []messageQueueRef
+	cursors  map[string]int64 // key: queueOffsetKey，下一次拉取的起始位点
+	routesAt time.Time
+
+	mu     sync.Mutex
+	status model.TailStatus
+}
+
+// StartTail 开始实时跟踪 Topic 的新消息，新消息通过 message:tail 事件推送
+// 从各队列当前的最大位点开始，按轮询间隔拉取新写入的消息；超过速率上限的消息直接跳过以保持实时。
+func (s *MessageService) StartTail(req model.TailRequest) (*model.TailStatus, error) {
+	topic := strings.TrimSpace(req.Topic)
+	if topic == "" {
+		return nil, fmt.Errorf("开始实时跟踪失败: Topic 不能为空")
+	}
+
+	filter, _, err := newMessageFilter(model.FilterTag, req.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("开始实时跟踪失败: %w", err)
+	}
+
+	maxRate := req.MaxRatePerSecond
+	if maxRate <= 0 {
+		maxRate = defaultTailRatePerSecond
+	}
+	if maxRate > maxTailRatePerSecond {
+		maxRate = maxTailRatePerSecond
+	}
+	intervalMillis := req.IntervalMillis
+	if intervalMillis <= 0 {
+		intervalMillis = defaultTailIntervalMillis
+	}
+	if intervalMillis < minTailIntervalMillis {
+		intervalMillis = minTailIntervalMillis
+	}
+	durationSeconds := req.MaxDurationSeconds
+	if durationSeconds <= 0 {
+		durationSeconds = defaultTailDurationSeconds
+	}
+	if durationSeconds > maxTailDurationSeconds {
+		durationSeconds = maxTailDurationSeconds
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	queues, err := fetchTopicQueues(client, topic)
+	if err != nil {
+		return nil, fmt.Errorf("获取 Topic %s 路由失败: %w", topic, err)
+	}
+	if len(queues) == 0 {
+		return nil, fmt.Errorf("开始实时跟踪失败: Topic %s 没有可读队列", topic)
+	}
+
+	cursors := make(map[string]int64, len(queues))
+	for _, queue := range queues {
+		_, maxOffset, err := fetchQueueOffsetRange(client, queue)
+		if err != nil {
+			return nil, fmt.Errorf("获取队列 %s-%d 位点失败: %w", queue.mq.BrokerName, queue.mq.QueueId, err)
+		}
+		cursors[queueOffsetKey(topic, queue.mq.BrokerName, queue.mq.QueueId)] = maxOffset
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tail := &topicTail{
+		id:       fmt.Sprintf("tail-%d", s.getNextID()),
+		topic:    topic,
+		filter:   filter,
+		maxRate:  maxRate,
+		interval: time.Duration(intervalMillis) * time.Millisecond,
+		duration: time.Duration(durationSeconds) * time.Second,
+		cancel:   cancel,
+		service:  s,
+		queues:   queues,
+		cursors:  cursors,
+		routesAt: time.Now(),
+	}
+	tail.status = model.TailStatus{
+		TailID:    tail.id,
+		Topic:     topic,
+		Tags:      strings.TrimSpace(req.Tags),
+		Running:   true,
+		StartedAt: formatNow(),
+	}
+
+	s.tailsMu.Lock()
+	if len(s.tails) >= maxConcurrentTails {
+		s.tailsMu.Unlock()
+		cancel()
+		return nil, fmt.Errorf("开始实时跟踪失败: 最多同时跟踪 %d 个 Topic，请先停止其他跟踪", maxConcurrentTails)
+	}
+	s.tails[tail.id] = tail
+	s.tailsMu.Unlock()
+
+	go tail.run(ctx)
+	return tail.snapshot(), nil
+}
+
+// StopTail 停止实时跟踪
+func (s *MessageService) StopTail(tailID string) error {
+	tailID = strings.TrimSpace(tailID)
+
+	s.tailsMu.Lock()
+	tail, ok := s.tails[tailID]
+	s.tailsMu.Unlock()
+	if !ok {
+		return fmt.Errorf("实时跟踪不存在或已停止: %s", tailID)
+	}
+
+	tail.stop("手动停止")
+	return nil
+}
+
+// GetTails 获取正在运行的实时跟踪
+func (s *MessageService) GetTails() []*model.TailStatus {
+	s.tailsMu.Lock()
+	tails := make([]*topicTail, 0, len(s.tails))
+	for _, tail := range s.tails {
+		tails = append(tails, tail)
+	}
+	s.tailsMu.Unlock()
+
+	result := make([]*model.TailStatus, 0, len(tails))
+	for _, tail := range tails {
+		result = append(result, tail.snapshot())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartedAt < result[j].StartedAt
+	})
+	return result
+}
+
+// ServiceShutdown 应用退出时停止所有实时跟踪、消息体搜索与消息回放
+func (s *MessageService) ServiceShutdown() error {
+	s.tailsMu.Lock()
+	tails := make([]*topicTail, 0, len(s.tails))
+	for _, tail := range s.tails {
+		tails = append(tails, tail)
+	}
+	s.tailsMu.Unlock()
+
+	for _, tail := range tails {
+		tail.stop("应用退出")
+	}
+
+	s.searchesMu.Lock()
+	for _, search := range s.searches {
+		search.cancel()
+	}
+	s.searchesMu.Unlock()
+
+	s.replaysMu.Lock()
+	for _, replay := range s.replays {
+		replay.cancel()
+	}
+	s.replaysMu.Unlock()
+	return nil
+}
+
+func (t *topicTail) run(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	deadline := time.NewTimer(t.duration)
+	defer deadline.Stop()
+
+	defer func() {
+		t.service.tailsMu.Lock()
+		if t.service.tails[t.id] == t {
+			delete(t.service.tails, t.id)
+		}
+		t.service.tailsMu.Unlock()
+
+		t.mu.Lock()
+		t.status.Running = false
+		t.mu.Unlock()
+		emitEvent(EventMessageTailStatus, *t.snapshot())
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline.C:
+			t.stop(fmt.Sprintf("已达到最长跟踪时间 %d 秒", int(t.duration/time.Second)))
+			return
+		case <-ticker.C:
+			t.poll(ctx)
+		}
+	}
+}
+
+// stop 记录停止原因并结束跟踪协程，重复调用时保留第一次的原因
+func (t *topicTail) stop(reason string) {
+	t.mu.Lock()
+	if t.status.StopReason == "" {
+		t.status.StopReason = reason
+	}
+	t.mu.Unlock()
+	t.cancel()
+}
+
+func (t *topicTail) snapshot() *model.TailStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	status := t.status
+	return &status
+}
+
+// poll 拉取各队列自上次以来的新消息并推送
+// 每轮最多拉取 maxRate × 轮询间隔 条消息，按队列平分，前面队列用不完的额度顺延给后面的队列；
+// 单个队列超出额度的部分从队列末尾往前保留，其余计为跳过。
+func (t *topicTail) poll(ctx context.Context) {
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		t.recordError(fmt.Errorf("获取客户端失败: %w", err))
+		return
+	}
+
+	if time.Since(t.routesAt) >= tailRouteRefreshInterval {
+		t.refreshQueues(client)
+	}
+
+	budget := int64(float64(t.maxRate) * t.interval.Seconds())
+	if budget < 1 {
+		budget = 1
+	}
+
+	msgs := make([]*admin.MessageExt, 0)
+	var received, filtered, dropped int64
+	var pollErr error
+	for i, queue := range t.queues {
+		if ctx.Err() != nil {
+			return
+		}
+		// 剩余额度向上取整平分给剩余队列
+		left := int64(len(t.queues) - i)
+		share := (budget + left - 1) / left
+
+		key := queueOffsetKey(t.topic, queue.mq.BrokerName, queue.mq.QueueId)
+		_, maxOffset, err := fetchQueueOffsetRange(client, queue)
+		if err != nil {
+			pollErr = fmt.Errorf("获取队列 %s-%d 位点失败: %w", queue.mq.BrokerName, queue.mq.QueueId, err)
+			continue
+		}
+
+		cursor := t.cursors[key]
+		if maxOffset <= cursor {
+			// 队列被清理或重建时位点可能回退，从新的末尾继续
+			t.cursors[key] = maxOffset
+			continue
+		}
+		if available := maxOffset - cursor; available > share {
+			dropped += available - share
+			cursor = maxOffset - share
+		}
+		if cursor >= maxOffset {
+			t.cursors[key] = maxOffset
+			continue
+		}
+
+		next, err := scanQueueRange(ctx, client, queue, cursor, maxOffset, func(msg *admin.MessageExt) bool {
+			received++
+			budget--
+			if truth, _ := t.filter.match(msg); truth == sqlTrue {
+				msgs = append(msgs, msg)
+			} else {
+				filtered++
+			}
+			return true
+		})
+		t.cursors[key] = next
+		if err != nil && ctx.Err() == nil {
+			pollErr = err
+		}
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].StoreTimestamp < msgs[j].StoreTimestamp
+	})
+	items := make([]*model.MessageItem, 0, len(msgs))
+	for _, msg := range msgs {
+		items = append(items, t.service.toMessageItem(msg))
+	}
+
+	t.mu.Lock()
+	t.status.Received += received
+	t.status.Emitted += int64(len(items))
+	t.status.Filtered += filtered
+	t.status.Dropped += dropped
+	t.mu.Unlock()
+
+	if pollErr != nil {
+		t.recordError(pollErr)
+	} else {
+		t.mu.Lock()
+		t.status.LastError = ""
+		t.mu.Unlock()
+	}
+
+	if len(items) > 0 || dropped > 0 {
+		emitEvent(EventMessageTail, model.TailMessagesEvent{
+			TailID:    t.id,
+			Topic:     t.topic,
+			Messages:  items,
+			Dropped:   dropped,
+			EmittedAt: formatNow(),
+		})
+	}
+}
+
+// refreshQueues 刷新 Topic 路由，新增的队列从最小位点开始跟踪
+func (t *topicTail) refreshQueues(client *admin.Client) {
+	t.routesAt = time.Now()
+
+	queues, err := fetchTopicQueues(client, t.topic)
+	if err != nil {
+		t.recordError(fmt.Errorf("刷新 Topic 路由失败: %w", err))
+		return
+	}
+
+	for _, queue := range queues {
+		key := queueOffsetKey(t.topic, queue.mq.BrokerName, queue.mq.QueueId)
+		if _, ok := t.cursors[key]; ok {
+			continue
+		}
+		minOffset, _, err := fetchQueueOffsetRange(client, queue)
+		if err != nil {
+			continue
+		}
+		t.cursors[key] = minOffset
+	}
+	t.queues = queues
+}
+
+// recordError 记录错误，错误内容变化时推送状态事件
+func (t *topicTail) recordError(err error) {
+	t.mu.Lock()
+	changed := t.status.LastError != err.Error()
+	t.status.LastError = err.Error()
+	t.mu.Unlock()
+
+	if changed {
+		log.Printf("[MessageService] 实时跟踪 %s(%s) 出错: %v", t.id, t.topic, err)
+		emitEvent(EventMessageTailStatus, *t.snapshot())
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const (
+	defaultTimeQueryPageSize   = 20
+	defaultTimeQueryMaxResults = 2000
+	maxTimeQueryMaxResults     = 10000
+	maxCachedTimeQueries       = 8
+	timeQueryCacheTTL          = 5 * time.Minute
+)
+
+// timeQueryResult 一次按时间查询拉取并排序后的窗口结果，翻页时复用
+type timeQueryResult struct {
+	topic      string
+	startTime  int64
+	endTime    int64 // 请求的结束时间，0 表示查询时的当前时间
+	maxResults int
+	msgs       []*admin.MessageExt // 按存储时间从新到旧排序
+	inRange    int64
+	truncated  bool
+	expiresAt  time.Time
+}
+
+// timeQueryCache 按查询ID缓存最近的按时间查询结果
+type timeQueryCache struct {
+	mu      sync.Mutex
+	results map[string]*timeQueryResult
+}
+
+func newTimeQueryCache() *timeQueryCache {
+	return &timeQueryCache{results: make(map[string]*timeQueryResult)}
+}
+
+// get 获取未过期且查询条件一致的缓存结果
+func (c *timeQueryCache) get(queryID, topic string, startTime, endTime int64, maxResults int) (*timeQueryResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result, ok := c.results[queryID]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(result.expiresAt) {
+		delete(c.results, queryID)
+		return nil, false
+	}
+	if result.topic != topic || result.startTime != startTime || result.endTime != endTime || result.maxResults != maxResults {
+		return nil, false
+	}
+	result.expiresAt = time.Now().Add(timeQueryCacheTTL)
+	return result, true
+}
+
+// put 缓存查询结果，超过上限时淘汰最早过期的结果
+func (c *timeQueryCache) put(queryID string, result *timeQueryResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for id, cached := range c.results {
+		if now.After(cached.expiresAt) {
+			delete(c.results, id)
+		}
+	}
+	for len(c.results) >= maxCachedTimeQueries {
+		oldestID := ""
+		for id, cached := range c.results {
+			if oldestID == "" || cached.expiresAt.Before(c.results[oldestID].expiresAt) {
+				oldestID = id
+			}
+		}
+		delete(c.results, oldestID)
+	}
+
+	result.expiresAt = now.Add(timeQueryCacheTTL)
+	c.results[queryID] = result
+}
+
+// QueryMessagesByTime 按时间范围浏览 Topic 的消息
+// 按存储时间戳定位每个队列的起止位点后拉取窗口内的消息，合并后按存储时间从新到旧排序分页。
+// 结果最多保留 MaxResults 条最新的消息，每个队列只需拉取窗口末尾的 MaxResults 条即可保证结果正确。
+// 首次查询的结果按返回的 QueryID 缓存 5 分钟，翻页时传回 QueryID 即可直接分页，不再重新拉取。
+func (s *MessageService) QueryMessagesByTime(params model.MessageQueryParams) (*model.MessagePage, error) {
+	topic := strings.TrimSpace(params.Topic)
+	if topic == "" {
+		return nil, fmt.Errorf("按时间查询消息失败: Topic 不能为空")
+	}
+	if params.EndTime > 0 && params.StartTime > params.EndTime {
+		return nil, fmt.Errorf("按时间查询消息失败: 开始时间不能晚于结束时间")
+	}
+
+	pageNum := params.PageNum
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultTimeQueryPageSize
+	}
+	maxResults := params.MaxResults
+	if maxResults <= 0 {
+		maxResults = defaultTimeQueryMaxResults
+	}
+	if maxResults > maxTimeQueryMaxResults {
+		maxResults = maxTimeQueryMaxResults
+	}
+
+	queryID := strings.TrimSpace(params.QueryID)
+	result, ok := s.timeQueries.get(queryID, topic, params.StartTime, params.EndTime, maxResults)
+	if !ok {
+		var err error
+		if result, err = fetchTimeQueryResult(topic, params.StartTime, params.EndTime, maxResults); err != nil {
+			return nil, err
+		}
+		queryID = fmt.Sprintf("query-%d", s.getNextID())
+		s.timeQueries.put(queryID, result)
+	}
+
+	page := &model.MessagePage{
+		Topic:     topic,
+		Messages:  make([]*model.MessageItem, 0),
+		Total:     len(result.msgs),
+		InRange:   result.inRange,
+		PageNum:   pageNum,
+		PageSize:  pageSize,
+		Truncated: result.truncated,
+		QueryID:   queryID,
+	}
+
+	from := (pageNum - 1) * pageSize
+	if from < len(result.msgs) {
+		to := from + pageSize
+		if to > len(result.msgs) {
+			to = len(result.msgs)
+		}
+		for _, msg := range result.msgs[from:to] {
+			page.Messages = append(page.Messages, s.toMessageItem(msg))
+		}
+	}
+
+	return page, nil
+}
+
+// fetchTimeQueryResult 拉取各队列时间窗口内的消息，合并后按存储时间从新到旧排序并截取最新的 maxResults 条
+func fetchTimeQueryResult(topic string, startTime, requestedEnd int64, maxResults int) (*timeQueryResult, error) {
+	endTime := requestedEnd
+	if endTime <= 0 {
+		endTime = time.Now().UnixMilli()
+	}
+	if startTime > endTime {
+		return nil, fmt.Errorf("按时间查询消息失败: 开始时间不能晚于结束时间")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	queues, err := fetchTopicQueues(client, topic)
+	if err != nil {
+		return nil, fmt.Errorf("获取 Topic %s 路由失败: %w", topic, err)
+	}
+
+	result := &timeQueryResult{
+		topic:      topic,
+		startTime:  startTime,
+		endTime:    requestedEnd,
+		maxResults: maxResults,
+		msgs:       make([]*admin.MessageExt, 0),
+	}
+	for _, queue := range queues {
+		start, end, err := resolveQueueTimeRange(client, queue, startTime, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("获取队列 %s-%d 位点失败: %w", queue.mq.BrokerName, queue.mq.QueueId, err)
+		}
+		if end <= start {
+			continue
+		}
+
+		result.inRange += end - start
+		if end-start > int64(maxResults) {
+			start = end - int64(maxResults)
+			result.truncated = true
+		}
+
+		_, err = scanQueueRange(context.Background(), client, queue, start, end, func(msg *admin.MessageExt) bool {
+			if msg.StoreTimestamp >= startTime && msg.StoreTimestamp <= endTime {
+				result.msgs = append(result.msgs, msg)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	msgs := result.msgs
+	sort.Slice(msgs, func(i, j int) bool {
+		if msgs[i].StoreTimestamp != msgs[j].StoreTimestamp {
+			return msgs[i].StoreTimestamp > msgs[j].StoreTimestamp
+		}
+		return msgs[i].QueueOffset > msgs[j].QueueOffset
+	})
+	if len(msgs) > maxResults {
+		result.msgs = msgs[:maxResults:maxResults]
+		result.truncated = true
+	}
+	return result, nil
+}
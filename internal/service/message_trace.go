@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+const (
+	defaultTraceTopic      = "RMQ_SYS_TRACE_TOPIC"
+	defaultTraceMaxResults = 64
+
+	// 轨迹数据分隔符，与客户端 TraceDataEncoder 一致
+	traceContentSplitter = "\u0001"
+	traceFieldSplitter   = "\u0002"
+
+	traceStatusSuccess   = "success"
+	traceStatusFailed    = "failed"
+	traceStatusConsuming = "consuming"
+
+	traceTransactionCommit   = "COMMIT_MESSAGE"
+	traceTransactionRollback = "ROLLBACK_MESSAGE"
+)
+
+// traceMsgTypes 客户端 MessageType 枚举序号对应的名称
+var traceMsgTypes = []string{"Normal_Msg", "Trans_Msg_Half", "Trans_msg_Commit", "Delay_Msg", "Order_Msg"}
+
+// GetMessageTrace 按消息ID或 Key 查询消息轨迹，并按生产者组、消费者组整理为时间线
+// 轨迹消息以原消息ID和业务 Key 作为索引 Key 写入轨迹 Topic，因此通过 Key 索引查询。
+func (s *MessageService) GetMessageTrace(query model.MessageTraceQuery) (*model.MessageTraceResult, error) {
+	msgID := strings.TrimSpace(query.MsgID)
+	key := strings.TrimSpace(query.Key)
+	if msgID == "" && key == "" {
+		return nil, fmt.Errorf("查询消息轨迹失败: 消息ID和 Key 不能同时为空")
+	}
+	if msgID != "" {
+		key = msgID
+	}
+
+	traceTopic := strings.TrimSpace(query.TraceTopic)
+	if traceTopic == "" {
+		traceTopic = defaultTraceTopic
+	}
+	maxResults := query.MaxResults
+	if maxResults <= 0 {
+		maxResults = defaultTraceMaxResults
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	var traceMsgs []*admin.MessageExt
+	err = executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		msgs, callErr := retryClient.QueryMessage(ctx, traceTopic, key, maxResults, 0, time.Now().UnixMilli())
+		if callErr != nil {
+			return callErr
+		}
+		traceMsgs = msgs
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("查询轨迹消息失败: %w", err)
+	}
+
+	events := make([]model.TraceEvent, 0)
+	for _, traceMsg := range traceMsgs {
+		body, _, _ := decompressBody(traceMsg.Body, traceMsg.SysFlag)
+		for _, event := range decodeTraceData(string(body)) {
+			if msgID != "" && event.MsgID != msgID {
+				continue
+			}
+			event.ClientHost = traceMsg.BornHost
+			events = append(events, event)
+		}
+	}
+
+	return &model.MessageTraceResult{
+		TraceTopic: traceTopic,
+		Traces:     buildMessageTraces(events),
+	}, nil
+}
+
+// GetMessageTrack 获取消息轨迹（按消息ID查询默认轨迹 Topic）
+func (s *MessageService) GetMessageTrack(topic string, msgID string) (*model.MessageTraceResult, error) {
+	result, err := s.GetMessageTrace(model.MessageTraceQuery{MsgID: msgID})
+	if err != nil {
+		return nil, err
+	}
+
+	// 指定 Topic 时只保留该 Topic 的轨迹
+	topic = strings.TrimSpace(topic)
+	if topic != "" {
+		traces := make([]model.MessageTrace, 0, len(result.Traces))
+		for _, trace := range result.Traces {
+			if trace.Topic == "" || trace.Topic == topic {
+				traces = append(traces, trace)
+			}
+		}
+		result.Traces = traces
+	}
+	return result, nil
+}
+
+// decodeTraceData 解析轨迹消息体，一条轨迹消息可能包含多条记录
+func decodeTraceData(data string) []model.TraceEvent {
+	events := make([]model.TraceEvent, 0)
+	for _, record := range strings.Split(data, traceFieldSplitter) {
+		fields := strings.Split(record, traceContentSplitter)
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch model.TraceType(strings.TrimSpace(fields[0])) {
+		case model.TracePub:
+			if len(fields) < 12 {
+				continue
+			}
+			event := model.TraceEvent{
+				Type:       model.TracePub,
+				Timestamp:  parseInt64Safe(fields[1]),
+				Group:      fields[3],
+				Topic:      fields[4],
+				MsgID:      fields[5],
+				Tags:       fields[6],
+				Keys:       fields[7],
+				StoreHost:  fields[8],
+				BodyLength: parseIntSafe(fields[9]),
+				CostTime:   parseInt64Safe(fields[10]),
+				MsgType:    traceMsgType(fields[11]),
+				Success:    true,
+			}
+			if len(fields) > 12 {
+				event.OffsetMsgID = fields[12]
+			}
+			if len(fields) > 13 {
+				event.Success = parseBoolSafe(fields[13])
+			}
+			events = append(events, event)
+
+		case model.TraceSubBefore:
+			if len(fields) < 8 {
+				continue
+			}
+			events = append(events, model.TraceEvent{
+				Type:       model.TraceSubBefore,
+				Timestamp:  parseInt64Safe(fields[1]),
+				Group:      fields[3],
+				RequestID:  fields[4],
+				MsgID:      fields[5],
+				RetryTimes: parseIntSafe(fields[6]),
+				Keys:       fields[7],
+			})
+
+		case model.TraceSubAfter:
+			if len(fields) < 6 {
+				continue
+			}
+			event := model.TraceEvent{
+				Type:      model.TraceSubAfter,
+				RequestID: fields[1],
+				MsgID:     fields[2],
+				CostTime:  parseInt64Safe(fields[3]),
+				Success:   parseBoolSafe(fields[4]),
+				Keys:      fields[5],
+			}
+			if len(fields) > 6 {
+				event.ContextCode = parseIntSafe(fields[6])
+			}
+			if len(fields) > 8 {
+				event.Timestamp = parseInt64Safe(fields[7])
+				event.Group = fields[8]
+			}
+			events = append(events, event)
+
+		case model.TraceEndTransaction:
+			if len(fields) < 13 {
+				continue
+			}
+			events = append(events, model.TraceEvent{
+				Type:             model.TraceEndTransaction,
+				Timestamp:        parseInt64Safe(fields[1]),
+				Group:            fields[3],
+				Topic:            fields[4],
+				MsgID:            fields[5],
+				Tags:             fields[6],
+				Keys:             fields[7],
+				StoreHost:        fields[8],
+				MsgType:          traceMsgType(fields[9]),
+				TransactionID:    fields[10],
+				TransactionState: fields[11],
+				FromCheck:        parseBoolSafe(fields[12]),
+				Success:          true,
+			})
+		}
+	}
+
+	return events
+}
+
+// buildMessageTraces 按消息ID、组整理轨迹事件
+// 旧版本客户端的 SubAfter 不含时间与消费者组，按 RequestID 从对应的 SubBefore 补齐。
+func buildMessageTraces(events []model.TraceEvent) []model.MessageTrace {
+	before := make(map[string]model.TraceEvent)
+	for _, event := range events {
+		if event.Type == model.TraceSubBefore {
+			before[event.MsgID+"@"+event.RequestID] = event
+		}
+	}
+
+	traces := make(map[string]*model.MessageTrace)
+	timelines := make(map[string]map[string]*model.TraceGroupTimeline)
+	for _, event := range events {
+		if event.Type == model.TraceSubAfter {
+			if start, ok := before[event.MsgID+"@"+event.RequestID]; ok {
+				if event.Group == "" {
+					event.Group = start.Group
+				}
+				if event.Timestamp <= 0 {
+					event.Timestamp = start.Timestamp + event.CostTime
+				}
+				if event.ClientHost == "" {
+					event.ClientHost = start.ClientHost
+				}
+			}
+		}
+		event.Time = formatTimestamp(event.Timestamp)
+
+		trace, ok := traces[event.MsgID]
+		if !ok {
+			trace = &model.MessageTrace{MsgID: event.MsgID}
+			traces[event.MsgID] = trace
+			timelines[event.MsgID] = make(map[string]*model.TraceGroupTimeline)
+		}
+		if event.Type == model.TracePub || event.Type == model.TraceEndTransaction {
+			trace.Topic = event.Topic
+			trace.Tags = event.Tags
+			trace.Keys = event.Keys
+			if event.StoreHost != "" {
+				trace.StoreHost = event.StoreHost
+			}
+		}
+
+		role := model.TraceRoleConsumer
+		if event.Type == model.TracePub || event.Type == model.TraceEndTransaction {
+			role = model.TraceRoleProducer
+		}
+		timelineKey := string(role) + "@" + event.Group
+		timeline, ok := timelines[event.MsgID][timelineKey]
+		if !ok {
+			timeline = &model.TraceGroupTimeline{
+				Group:       event.Group,
+				Role:        role,
+				ClientHosts: make([]string, 0),
+				Events:      make([]model.TraceEvent, 0),
+			}
+			timelines[event.MsgID][timelineKey] = timeline
+		}
+		timeline.Events = append(timeline.Events, event)
+	}
+
+	result := make([]model.MessageTrace, 0, len(traces))
+	for _, msgID := range sortedKeys(traces) {
+		trace := traces[msgID]
+		trace.Groups = make([]model.TraceGroupTimeline, 0, len(timelines[msgID]))
+		for _, timeline := range timelines[msgID] {
+			summarizeTraceTimeline(timeline)
+			trace.Groups = append(trace.Groups, *timeline)
+		}
+		sort.Slice(trace.Groups, func(i, j int) bool {
+			a, b := trace.Groups[i], trace.Groups[j]
+			if a.Role != b.Role {
+				return a.Role == model.TraceRoleProducer
+			}
+			return a.Group < b.Group
+		})
+		result = append(result, *trace)
+	}
+
+	return result
+}
+
+// summarizeTraceTimeline 排序事件并计算耗时、尝试次数、客户端与最终状态
+// 事务消息以最后一次提交/回滚为准，未决（UNKNOW）的结束事务不改变状态。
+func summarizeTraceTimeline(timeline *model.TraceGroupTimeline) {
+	sort.SliceStable(timeline.Events, func(i, j int) bool {
+		return timeline.Events[i].Timestamp < timeline.Events[j].Timestamp
+	})
+
+	hosts := make(map[string]struct{})
+	pending := make(map[string]struct{})
+	timeline.Status = traceStatusFailed
+	for _, event := range timeline.Events {
+		if event.ClientHost != "" {
+			hosts[event.ClientHost] = struct{}{}
+		}
+
+		switch event.Type {
+		case model.TracePub:
+			timeline.Attempts++
+			timeline.TotalCost += event.CostTime
+			if event.Success {
+				timeline.Status = traceStatusSuccess
+			}
+		case model.TraceSubBefore:
+			timeline.Attempts++
+			pending[event.RequestID] = struct{}{}
+		case model.TraceSubAfter:
+			delete(pending, event.RequestID)
+			timeline.TotalCost += event.CostTime
+			if event.Success {
+				timeline.Status = traceStatusSuccess
+			}
+		case model.TraceEndTransaction:
+			switch event.TransactionState {
+			case traceTransactionCommit:
+				timeline.Status = traceStatusSuccess
+			case traceTransactionRollback:
+				timeline.Status = traceStatusFailed
+			}
+		}
+	}
+	if timeline.Role == model.TraceRoleConsumer && timeline.Status != traceStatusSuccess && len(pending) > 0 {
+		timeline.Status = traceStatusConsuming
+	}
+
+	for host := range hosts {
+		timeline.ClientHosts = append(timeline.ClientHosts, host)
+	}
+	sort.Strings(timeline.ClientHosts)
+}
+
+func traceMsgType(ordinal string) string {
+	index, err := strconv.Atoi(strings.TrimSpace(ordinal))
+	if err != nil || index < 0 || index >= len(traceMsgTypes) {
+		return ordinal
+	}
+	return traceMsgTypes[index]
+}
+
+func parseBoolSafe(value string) bool {
+	result, err := strconv.ParseBool(strings.TrimSpace(value))
+	return err == nil && result
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"rocket-leaf/internal/model"
+)
+
+// traceRecord 按客户端 TraceDataEncoder 的格式拼接一条轨迹记录
+func traceRecord(fields ...string) string {
+	return strings.Join(fields, traceContentSplitter) + traceFieldSplitter
+}
+
+func TestDecodeTraceData(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []model.TraceEvent
+	}{
+		{
+			name: "Pub",
+			data: traceRecord("Pub", "1700000000000", "DefaultRegion", "pg", "orders", "MSG1", "TagA", "k1 k2", "10.0.0.1:10911", "128", "5", "0", "OFFSET1", "false"),
+			want: []model.TraceEvent{{
+				Type: model.TracePub, Timestamp: 1700000000000, Group: "pg", Topic: "orders", MsgID: "MSG1",
+				Tags: "TagA", Keys: "k1 k2", StoreHost: "10.0.0.1:10911", BodyLength: 128, CostTime: 5,
+				MsgType: "Normal_Msg", OffsetMsgID: "OFFSET1", Success: false,
+			}},
+		},
+		{
+			name: "旧版本 Pub 缺少偏移消息ID与结果",
+			data: traceRecord("Pub", "1700000000000", "DefaultRegion", "pg", "orders", "MSG1", "", "", "10.0.0.1:10911", "1", "2", "3"),
+			want: []model.TraceEvent{{
+				Type: model.TracePub, Timestamp: 1700000000000, Group: "pg", Topic: "orders", MsgID: "MSG1",
+				StoreHost: "10.0.0.1:10911", BodyLength: 1, CostTime: 2, MsgType: "Delay_Msg", Success: true,
+			}},
+		},
+		{
+			name: "一条消息体包含 SubBefore 与 SubAfter",
+			data: traceRecord("SubBefore", "1700000000100", "DefaultRegion", "cg", "REQ1", "MSG1", "2", "k1") +
+				traceRecord("SubAfter", "REQ1", "MSG1", "30", "true", "k1", "0", "1700000000130", "cg"),
+			want: []model.TraceEvent{
+				{Type: model.TraceSubBefore, Timestamp: 1700000000100, Group: "cg", RequestID: "REQ1", MsgID: "MSG1", RetryTimes: 2, Keys: "k1"},
+				{Type: model.TraceSubAfter, Timestamp: 1700000000130, Group: "cg", RequestID: "REQ1", MsgID: "MSG1", CostTime: 30, Success: true, Keys: "k1"},
+			},
+		},
+		{
+			name: "旧版本 SubAfter 不含时间与消费者组",
+			data: traceRecord("SubAfter", "REQ1", "MSG1", "30", "false", "k1"),
+			want: []model.TraceEvent{
+				{Type: model.TraceSubAfter, RequestID: "REQ1", MsgID: "MSG1", CostTime: 30, Success: false, Keys: "k1"},
+			},
+		},
+		{
+			name: "EndTransaction",
+			data: traceRecord("EndTransaction", "1700000000200", "DefaultRegion", "pg", "orders", "MSG1", "TagA", "k1", "10.0.0.1:10911", "1", "TX1", "COMMIT_MESSAGE", "true"),
+			want: []model.TraceEvent{{
+				Type: model.TraceEndTransaction, Timestamp: 1700000000200, Group: "pg", Topic: "orders", MsgID: "MSG1",
+				Tags: "TagA", Keys: "k1", StoreHost: "10.0.0.1:10911", MsgType: "Trans_Msg_Half",
+				TransactionID: "TX1", TransactionState: "COMMIT_MESSAGE", FromCheck: true, Success: true,
+			}},
+		},
+		{
+			name: "未知消息类型序号原样保留",
+			data: traceRecord("Pub", "1", "r", "pg", "t", "MSG1", "", "", "h", "0", "0", "9"),
+			want: []model.TraceEvent{{Type: model.TracePub, Timestamp: 1, Group: "pg", Topic: "t", MsgID: "MSG1", StoreHost: "h", MsgType: "9", Success: true}},
+		},
+		{
+			name: "字段不足或未知类型的记录被跳过",
+			data: traceRecord("Pub", "1", "r") + traceRecord("Unknown", "1", "2") + "garbage",
+			want: []model.TraceEvent{},
+		},
+		{name: "空消息体", data: "", want: []model.TraceEvent{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := decodeTraceData(tt.data)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("解析结果 = %+v\n期望 %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildMessageTraces(t *testing.T) {
+	events := decodeTraceData(
+		traceRecord("Pub", "1700000000000", "r", "pg", "orders", "MSG1", "TagA", "k1", "10.0.0.1:10911", "10", "5", "0", "OFF1", "true") +
+			traceRecord("SubBefore", "1700000000100", "r", "cg-ok", "REQ1", "MSG1", "0", "k1") +
+			traceRecord("SubAfter", "REQ1", "MSG1", "30", "true", "k1") +
+			traceRecord("SubBefore", "1700000000200", "r", "cg-busy", "REQ2", "MSG1", "0", "k1") +
+			traceRecord("SubBefore", "1700000000300", "r", "cg-fail", "REQ3", "MSG1", "0", "k1") +
+			traceRecord("SubAfter", "REQ3", "MSG1", "7", "false", "k1", "1", "1700000000307", "cg-fail") +
+			traceRecord("EndTransaction", "1700000000010", "r", "pg-tx-commit", "orders", "MSG1", "TagA", "k1", "10.0.0.1:10911", "2", "TX1", "COMMIT_MESSAGE", "false") +
+			traceRecord("EndTransaction", "1700000000020", "r", "pg-tx-rollback", "orders", "MSG1", "TagA", "k1", "10.0.0.1:10911", "2", "TX2", "ROLLBACK_MESSAGE", "true"))
+
+	traces := buildMessageTraces(events)
+	if len(traces) != 1 {
+		t.Fatalf("轨迹数 = %d, 期望 1", len(traces))
+	}
+	trace := traces[0]
+	if trace.MsgID != "MSG1" || trace.Topic != "orders" || trace.Tags != "TagA" || trace.StoreHost != "10.0.0.1:10911" {
+		t.Errorf("消息信息 = %+v", trace)
+	}
+
+	type groupSummary struct {
+		group    string
+		role     model.TraceRole
+		status   string
+		attempts int
+		cost     int64
+	}
+	want := []groupSummary{
+		{group: "pg", role: model.TraceRoleProducer, status: traceStatusSuccess, attempts: 1, cost: 5},
+		{group: "pg-tx-commit", role: model.TraceRoleProducer, status: traceStatusSuccess},
+		{group: "pg-tx-rollback", role: model.TraceRoleProducer, status: traceStatusFailed},
+		{group: "cg-busy", role: model.TraceRoleConsumer, status: traceStatusConsuming, attempts: 1},
+		{group: "cg-fail", role: model.TraceRoleConsumer, status: traceStatusFailed, attempts: 1, cost: 7},
+		{group: "cg-ok", role: model.TraceRoleConsumer, status: traceStatusSuccess, attempts: 1, cost: 30},
+	}
+	got := make([]groupSummary, 0, len(trace.Groups))
+	for _, timeline := range trace.Groups {
+		got = append(got, groupSummary{timeline.Group, timeline.Role, timeline.Status, timeline.Attempts, timeline.TotalCost})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("时间线 = %+v\n期望 %+v", got, want)
+	}
+
+	// 旧版本 SubAfter 按 RequestID 从 SubBefore 补齐消费者组与时间
+	okEvents := trace.Groups[5].Events
+	if len(okEvents) != 2 || okEvents[1].Type != model.TraceSubAfter || okEvents[1].Timestamp != 1700000000130 {
+		t.Errorf("cg-ok 事件 = %+v", okEvents)
+	}
+}
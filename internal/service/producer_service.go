@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"rocket-leaf/internal/model"
+	"rocket-leaf/internal/rocketmq"
+
+	admin "github.com/codermast/rocketmq-admin-go"
+)
+
+// ProducerService 生产者组服务
+type ProducerService struct {
+	nextID int64
+}
+
+// NewProducerService 创建生产者组服务
+func NewProducerService() *ProducerService {
+	return &ProducerService{
+		nextID: 1,
+	}
+}
+
+func (s *ProducerService) getNextID() int {
+	return int(atomic.AddInt64(&s.nextID, 1))
+}
+
+// GetProducerGroups 获取所有 Master Broker 上当前有连接的生产者组
+// Broker 只保存在线生产者的心跳，没有连接的生产者组不会出现在列表中。
+func (s *ProducerService) GetProducerGroups() ([]*model.ProducerGroupItem, error) {
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return make([]*model.ProducerGroupItem, 0), nil
+	}
+
+	tables, err := fetchProducerTables(client)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*model.ProducerGroupItem)
+	for _, brokerName := range sortedKeys(tables) {
+		for groupName, producers := range tables[brokerName].Data {
+			if isSystemProducerGroup(groupName) {
+				continue
+			}
+
+			item, ok := groups[groupName]
+			if !ok {
+				item = newProducerGroupItem(s.getNextID(), groupName)
+				groups[groupName] = item
+			}
+			item.Brokers = append(item.Brokers, brokerName)
+
+			for _, producer := range producers {
+				item.Clients = append(item.Clients, newProducerClient(
+					producer.ClientId, producer.RemoteIP, producer.Language, int(producer.Version), brokerName,
+					formatTimestamp(producer.LastUpdateTimestamp),
+				))
+			}
+		}
+	}
+
+	result := make([]*model.ProducerGroupItem, 0, len(groups))
+	for _, groupName := range sortedKeys(groups) {
+		item := groups[groupName]
+		finishProducerGroupItem(item)
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// GetTopicProducers 获取向 Topic 所在 Broker 建立了连接的生产者组
+// Broker 按生产者组而非 Topic 记录连接，groups 为空时先从 Topic 所在 Broker 汇总生产者组再逐个查询。
+func (s *ProducerService) GetTopicProducers(topic string, groups []string) (*model.TopicProducers, error) {
+	topic = strings.TrimSpace(topic)
+	if topic == "" {
+		return nil, fmt.Errorf("获取 Topic 生产者失败: Topic 名称不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	tables, err := fetchTopicProducerTables(client, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		onlineGroups := make(map[string]struct{})
+		for _, table := range tables {
+			for groupName := range table.Data {
+				if !isSystemProducerGroup(groupName) {
+					onlineGroups[groupName] = struct{}{}
+				}
+			}
+		}
+		groups = sortedKeys(onlineGroups)
+	}
+
+	result := &model.TopicProducers{
+		Topic:  topic,
+		Groups: make([]*model.ProducerGroupItem, 0),
+	}
+	for _, group := range groups {
+		item, err := s.producerConnections(client, tables, strings.TrimSpace(group), topic)
+		if err != nil {
+			return nil, err
+		}
+		if item.OnlineClients > 0 {
+			result.Groups = append(result.Groups, item)
+		}
+	}
+
+	return result, nil
+}
+
+// GetProducerConnections 获取生产者组在 Topic 所在 Broker 上的连接
+func (s *ProducerService) GetProducerConnections(group string, topic string) (*model.ProducerGroupItem, error) {
+	group = strings.TrimSpace(group)
+	topic = strings.TrimSpace(topic)
+	if group == "" || topic == "" {
+		return nil, fmt.Errorf("获取生产者连接失败: 生产者组和 Topic 不能为空")
+	}
+
+	client, err := rocketmq.GetClientManager().GetDefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("获取客户端失败: %w", err)
+	}
+
+	tables, err := fetchTopicProducerTables(client, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.producerConnections(client, tables, group, topic)
+}
+
+// producerConnections 查询生产者组在 Topic 所在 Broker 上的连接
+// Broker 在生产者组没有连接时返回错误，因此先用 Topic 所在 Broker 的生产者心跳表判断是否在线，
+// 不在线时直接返回空结果，在线时查询失败的错误原样返回；最后心跳时间与 Broker 也取自心跳表。
+func (s *ProducerService) producerConnections(client *admin.Client, tables map[string]*admin.ProducerTableInfo, group string, topic string) (*model.ProducerGroupItem, error) {
+	item := newProducerGroupItem(s.getNextID(), group)
+
+	// clientID -> 心跳表中最近一次心跳
+	type heartbeat struct {
+		brokerName string
+		timestamp  int64
+	}
+	heartbeats := make(map[string]heartbeat)
+	for _, brokerName := range sortedKeys(tables) {
+		producers, ok := tables[brokerName].Data[group]
+		if !ok {
+			continue
+		}
+		item.Brokers = append(item.Brokers, brokerName)
+		for _, producer := range producers {
+			if last, ok := heartbeats[producer.ClientId]; !ok || producer.LastUpdateTimestamp > last.timestamp {
+				heartbeats[producer.ClientId] = heartbeat{brokerName: brokerName, timestamp: producer.LastUpdateTimestamp}
+			}
+		}
+	}
+	if len(heartbeats) == 0 {
+		finishProducerGroupItem(item)
+		return item, nil
+	}
+
+	var connInfo *admin.ProducerConnection
+	err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		info, callErr := retryClient.ExamineProducerConnectionInfo(ctx, group, topic)
+		if callErr != nil {
+			return callErr
+		}
+		connInfo = info
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取生产者组 %s 连接信息失败: %w", group, err)
+	}
+
+	if connInfo != nil {
+		for _, conn := range connInfo.ConnectionSet {
+			var brokerName, lastUpdate string
+			if last, ok := heartbeats[conn.ClientId]; ok {
+				brokerName = last.brokerName
+				lastUpdate = formatTimestamp(last.timestamp)
+			}
+			item.Clients = append(item.Clients, newProducerClient(
+				conn.ClientId, conn.ClientAddr, conn.Language, int(conn.Version), brokerName, lastUpdate,
+			))
+		}
+	}
+	finishProducerGroupItem(item)
+	return item, nil
+}
+
+// fetchProducerTables 获取所有 Master Broker 的生产者心跳表，key 为 BrokerName
+func fetchProducerTables(client *admin.Client) (map[string]*admin.ProducerTableInfo, error) {
+	masterAddrs, err := fetchMasterAddrs(client)
+	if err != nil {
+		return nil, fmt.Errorf("获取 Broker 列表失败: %w", err)
+	}
+
+	tables := make(map[string]*admin.ProducerTableInfo, len(masterAddrs))
+	for _, brokerName := range sortedKeys(masterAddrs) {
+		brokerAddr := masterAddrs[brokerName]
+
+		var tableInfo *admin.ProducerTableInfo
+		err := executeWithClientRetry(client, func(retryClient *admin.Client) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			info, callErr := retryClient.GetAllProducerInfo(ctx, brokerAddr)
+			if callErr != nil {
+				return callErr
+			}
+			tableInfo = info
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("获取 Broker %s 生产者信息失败: %w", brokerName, err)
+		}
+		if tableInfo != nil {
+			tables[brokerName] = tableInfo
+		}
+	}
+
+	return tables, nil
+}
+
+// fetchTopicProducerTables 获取 Topic 所在 Master Broker 的生产者心跳表，key 为 BrokerName
+// 连接只在 Topic 所在的 Broker 上查询，只向其他 Broker 发送心跳的生产者组在此视为不在线。
+func fetchTopicProducerTables(client *admin.Client, topic string) (map[string]*admin.ProducerTableInfo, error) {
+	tables, err := fetchProducerTables(client)
+	if err != nil {
+		return nil, err
+	}
+
+	queues, err := fetchTopicQueues(client, topic)
+	if err != nil {
+		return nil, fmt.Errorf("获取 Topic %s 路由失败: %w", topic, err)
+	}
+
+	topicTables := make(map[string]*admin.ProducerTableInfo)
+	for _, queue := range queues {
+		if table, ok := tables[queue.mq.BrokerName]; ok {
+			topicTables[queue.mq.BrokerName] = table
+		}
+	}
+	return topicTables, nil
+}
+
+func newProducerGroupItem(id int, group string) *model.ProducerGroupItem {
+	return &model.ProducerGroupItem{
+		ID:      id,
+		Group:   group,
+		Brokers: make([]string, 0),
+		Clients: make([]model.ProducerClient, 0),
+	}
+}
+
+func newProducerClient(clientID string, addr string, language string, versionCode int, brokerName string, lastUpdate string) model.ProducerClient {
+	return model.ProducerClient{
+		ClientID:    clientID,
+		IP:          addr,
+		Language:    rocketmq.LanguageDesc(language),
+		Version:     rocketmq.VersionDesc(versionCode),
+		VersionCode: versionCode,
+		BrokerName:  brokerName,
+		LastUpdate:  lastUpdate,
+	}
+}
+
+// finishProducerGroupItem 排序客户端并统计在线数与版本混用情况
+// 同一客户端会向多个 Broker 发送心跳，在线数按客户端ID去重。
+func finishProducerGroupItem(item *model.ProducerGroupItem) {
+	sort.Slice(item.Clients, func(i, j int) bool {
+		if item.Clients[i].ClientID != item.Clients[j].ClientID {
+			return item.Clients[i].ClientID < item.Clients[j].ClientID
+		}
+		return item.Clients[i].BrokerName < item.Clients[j].BrokerName
+	})
+	sort.Strings(item.Brokers)
+
+	clientIDs := make(map[string]struct{})
+	versions := make(rocketmq.ClientVersionSet)
+	for _, c := range item.Clients {
+		clientIDs[c.ClientID] = struct{}{}
+		versions.Add(c.Language, c.VersionCode)
+	}
+	item.OnlineClients = len(clientIDs)
+	item.MixedVersions = versions.Mixed()
+}
+
+// 判断是否为 RocketMQ 内部生产者组
+func isSystemProducerGroup(group string) bool {
+	switch group {
+	case "CLIENT_INNER_PRODUCER", "SELF_TEST_P_GROUP", "TOOLS_PRODUCER":
+		return true
+	}
+	return false
+}
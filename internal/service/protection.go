@@ -0,0 +1,30 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"rocket-leaf/internal/rocketmq"
+)
+
+// checkProtectionConfirm 默认连接处于保护模式（生产环境）时，要求调用方输入 expected 作为确认
+func checkProtectionConfirm(action string, confirm string, expected string) error {
+	if !rocketmq.GetClientManager().IsDefaultProtected() {
+		return nil
+	}
+	if strings.TrimSpace(confirm) != expected {
+		return fmt.Errorf("当前连接处于保护模式（生产环境），%s需要输入 %q 进行确认", action, expected)
+	}
+	return nil
+}
+
+// checkConnectionProtectionConfirm 指定连接处于保护模式时，要求调用方输入 expected 作为确认
+func checkConnectionProtectionConfirm(nameServer string, action string, confirm string, expected string) error {
+	if !rocketmq.GetClientManager().IsProtected(nameServer) {
+		return nil
+	}
+	if strings.TrimSpace(confirm) != expected {
+		return fmt.Errorf("连接 %s 处于保护模式（生产环境），%s需要输入 %q 进行确认", nameServer, action, expected)
+	}
+	return nil
+}
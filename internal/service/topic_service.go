@@ -280,6 +280,11 @@ func (s *TopicService) DeleteTopic(topic string, clusterName string) error {
 		return fmt.Errorf("删除 Topic 失败: Topic 名称不能为空")
 	}
 
+	return deleteTopicInClusters(client, topic, clusterName)
+}
+
+// deleteTopicInClusters 在 Topic 所属集群中删除 Topic，未指定集群时根据路由信息或全部集群依次尝试
+func deleteTopicInClusters(client *admin.Client, topic string, clusterName string) error {
 	clusterCandidates := make([]string, 0, 4)
 	seenClusters := make(map[string]struct{})
 	appendCluster := func(name string) {
@@ -6,6 +6,7 @@ import (
 	"rocket-leaf/internal/rocketmq"
 	"time"
 
+	"rocket-leaf/internal/model"
 	"rocket-leaf/internal/service"
 
 	"github.com/wailsapp/wails/v3/pkg/application"
@@ -26,6 +27,7 @@ var (
 	topicService      *service.TopicService
 	consumerService   *service.ConsumerService
 	messageService    *service.MessageService
+	producerService   *service.ProducerService
 )
 
 func init() {
@@ -33,6 +35,13 @@ func init() {
 	// This is not required, but the binding generator will pick up registered events
 	// and provide a strongly typed JS/TS API for them.
 	application.RegisterEvent[string]("time")
+	application.RegisterEvent[model.StuckConsumerEvent](service.EventConsumerStuck)
+	application.RegisterEvent[model.TailMessagesEvent](service.EventMessageTail)
+	application.RegisterEvent[model.TailStatus](service.EventMessageTailStatus)
+	application.RegisterEvent[model.BodySearchProgress](service.EventSearchProgress)
+	application.RegisterEvent[model.BodySearchMatchEvent](service.EventSearchMatch)
+	application.RegisterEvent[model.MessageReplayProgress](service.EventReplayProgress)
+	application.RegisterEvent[model.MessageReplayItemsEvent](service.EventReplayItems)
 
 	// 初始化后端服务
 	connectionService = service.NewConnectionService()
@@ -40,6 +49,7 @@ func init() {
 	topicService = service.NewTopicService()
 	consumerService = service.NewConsumerService()
 	messageService = service.NewMessageService()
+	producerService = service.NewProducerService()
 
 	// 配置默认连接的懒初始化，业务接口首次访问时自动尝试连接默认连接
 	rocketmq.GetClientManager().SetDefaultClientInitializer(connectionService.ConnectDefault)
@@ -64,6 +74,7 @@ func main() {
 			application.NewService(topicService),      // Topic 管理服务
 			application.NewService(consumerService),   // 消费者组服务
 			application.NewService(messageService),    // 消息查询服务
+			application.NewService(producerService),   // 生产者组服务
 		},
 		Assets: application.AssetOptions{
 			Handler: application.AssetFileServerFS(assets),